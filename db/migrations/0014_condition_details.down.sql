@@ -0,0 +1,12 @@
+-- 0014_condition_details.down.sql
+-- Drop survivor condition progression details.
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name='survivors' AND column_name='condition_details'
+    ) THEN
+        EXECUTE 'ALTER TABLE survivors DROP COLUMN condition_details';
+    END IF;
+END$$;
@@ -0,0 +1,12 @@
+-- 0014_condition_details.up.sql
+-- Track severity, onset turn and age for each active survivor condition.
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name='survivors' AND column_name='condition_details'
+    ) THEN
+        EXECUTE 'ALTER TABLE survivors ADD COLUMN condition_details JSONB NOT NULL DEFAULT ''{}''::jsonb';
+    END IF;
+END$$;
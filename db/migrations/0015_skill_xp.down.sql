@@ -0,0 +1,12 @@
+-- 0015_skill_xp.down.sql
+-- Drop survivor skill experience.
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name='survivors' AND column_name='skill_xp'
+    ) THEN
+        EXECUTE 'ALTER TABLE survivors DROP COLUMN skill_xp';
+    END IF;
+END$$;
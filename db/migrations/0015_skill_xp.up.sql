@@ -0,0 +1,12 @@
+-- 0015_skill_xp.up.sql
+-- Track per-skill experience toward the next level.
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name='survivors' AND column_name='skill_xp'
+    ) THEN
+        EXECUTE 'ALTER TABLE survivors ADD COLUMN skill_xp JSONB NOT NULL DEFAULT ''{}''::jsonb';
+    END IF;
+END$$;
@@ -0,0 +1,12 @@
+-- 0016_run_content_packs.down.sql
+-- Drop run content pack records.
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name='runs' AND column_name='content_packs'
+    ) THEN
+        EXECUTE 'ALTER TABLE runs DROP COLUMN content_packs';
+    END IF;
+END$$;
@@ -0,0 +1,12 @@
+-- 0016_run_content_packs.up.sql
+-- Record the content packs (id@version) each run's event catalog was built from.
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name='runs' AND column_name='content_packs'
+    ) THEN
+        EXECUTE 'ALTER TABLE runs ADD COLUMN content_packs TEXT[] NOT NULL DEFAULT ''{}''';
+    END IF;
+END$$;
@@ -0,0 +1,12 @@
+-- 0017_survivor_last_custom.down.sql
+-- Drop the last custom action archetype.
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name='survivors' AND column_name='last_custom'
+    ) THEN
+        EXECUTE 'ALTER TABLE survivors DROP COLUMN last_custom';
+    END IF;
+END$$;
@@ -0,0 +1,12 @@
+-- 0017_survivor_last_custom.up.sql
+-- Remember the archetype of the last custom action so repetition limits survive reloads.
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name='survivors' AND column_name='last_custom'
+    ) THEN
+        EXECUTE 'ALTER TABLE survivors ADD COLUMN last_custom TEXT NOT NULL DEFAULT ''''';
+    END IF;
+END$$;
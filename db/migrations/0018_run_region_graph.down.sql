@@ -0,0 +1,12 @@
+-- 0018_run_region_graph.down.sql
+-- Drop persisted run region graphs.
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name='runs' AND column_name='region_graph'
+    ) THEN
+        EXECUTE 'ALTER TABLE runs DROP COLUMN region_graph';
+    END IF;
+END$$;
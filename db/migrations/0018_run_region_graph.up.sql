@@ -0,0 +1,12 @@
+-- 0018_run_region_graph.up.sql
+-- Persist each run's region graph so every survivor in a region shares one LAD.
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name='runs' AND column_name='region_graph'
+    ) THEN
+        EXECUTE 'ALTER TABLE runs ADD COLUMN region_graph JSONB';
+    END IF;
+END$$;
@@ -0,0 +1,5 @@
+-- 0019_journeys.down.sql
+-- Drop recorded travel legs. The widened location check stays: survivors may already be
+-- somewhere the old seven-value list rejects.
+
+DROP TABLE IF EXISTS journeys;
@@ -0,0 +1,30 @@
+-- 0019_journeys.up.sql
+-- Travel legs survivors complete, for the world timeline.
+
+CREATE TABLE IF NOT EXISTS journeys (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    run_id UUID NOT NULL REFERENCES runs(id) ON DELETE CASCADE,
+    survivor_id UUID NOT NULL REFERENCES survivors(id) ON DELETE CASCADE,
+    mode TEXT NOT NULL CHECK (mode IN ('foot','vehicle','boat')),
+    link TEXT NOT NULL,
+    from_region TEXT NOT NULL,
+    to_region TEXT NOT NULL,
+    from_location TEXT NOT NULL,
+    to_location TEXT NOT NULL,
+    km DOUBLE PRECISION NOT NULL,
+    depart_day INT NOT NULL,
+    arrive_day INT NOT NULL,
+    lad_before INT NOT NULL,
+    lad_after INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_journeys_run_day ON journeys(run_id, arrive_day);
+
+-- Travel reaches every location type, so widen the original seven-value check.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_constraint WHERE conname='chk_survivors_location_type') THEN
+        ALTER TABLE survivors DROP CONSTRAINT chk_survivors_location_type;
+    END IF;
+    ALTER TABLE survivors ADD CONSTRAINT chk_survivors_location_type CHECK (location_type IN ('airport','city','suburb','rural','forest','coast','mountain','desert','industrial','subterranean','island','marsh','plateau','tundra','megastructure','canyon','harbor','research_outpost','stronghold'));
+END$$;
@@ -0,0 +1,4 @@
+-- 0020_companions.down.sql
+-- Drop survivor companions.
+
+DROP TABLE IF EXISTS companions;
@@ -0,0 +1,18 @@
+-- 0020_companions.up.sql
+-- Named companions travelling with a survivor. Dead and departed companions are kept with
+-- their status so the group's history survives.
+
+CREATE TABLE IF NOT EXISTS companions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    survivor_id UUID NOT NULL REFERENCES survivors(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    profession TEXT NOT NULL,
+    skills JSONB NOT NULL DEFAULT '{}'::jsonb,
+    health INT NOT NULL CHECK (health BETWEEN 0 AND 100),
+    morale INT NOT NULL CHECK (morale BETWEEN 0 AND 100),
+    loyalty INT NOT NULL CHECK (loyalty BETWEEN 0 AND 100),
+    status TEXT NOT NULL DEFAULT 'active' CHECK (status IN ('active','dead','left')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_companions_survivor_name ON companions(survivor_id, name);
@@ -0,0 +1,237 @@
+package engine
+
+import "sort"
+
+// archetypeProfile is the generic shape of a choice archetype: what it does to stats and
+// meters, what it costs, which skill it tests and which category of modifiers applies.
+type archetypeProfile struct {
+	BaseOutcome ChoiceOutcome
+	BaseEffects ChoiceEffect
+	BaseCost    Cost
+	Skill       Skill
+	Category    string   // physical | mental | rest | social | technical | stealth
+	Exertion    bool     // counts as high exertion on hard difficulty
+	Keywords    []string // phrases that map a custom action to this archetype
+}
+
+var archetypeProfiles = map[string]archetypeProfile{
+	"rest": {
+		BaseOutcome: ChoiceOutcome{
+			StatFatigue: {Min: -12, Max: -8},
+			StatMorale:  {Min: 1, Max: 2},
+		},
+		BaseEffects: ChoiceEffect{MeterDeltas: map[Meter]int{MeterNoise: -3, MeterVisibility: -3}},
+		BaseCost:    Cost{Time: 1},
+		Skill:       SkillSurvival,
+		Category:    "rest",
+		Keywords:    []string{"rest", "sleep", "recover", "nap"},
+	},
+	"forage": {
+		BaseOutcome: ChoiceOutcome{
+			StatHunger:  {Min: -8, Max: -5},
+			StatThirst:  {Min: -6, Max: -3},
+			StatFatigue: {Min: 4, Max: 7},
+		},
+		BaseEffects: ChoiceEffect{MeterDeltas: map[Meter]int{MeterNoise: 4, MeterScent: 4}},
+		BaseCost:    Cost{Time: 1, Fatigue: 3},
+		Skill:       SkillScavenging,
+		Category:    "physical",
+		Exertion:    true,
+		Keywords:    []string{"forage", "search", "scavenge", "look for", "gather"},
+	},
+	"scout": {
+		BaseOutcome: ChoiceOutcome{
+			StatFatigue: {Min: 5, Max: 8},
+			StatMorale:  {Min: 1, Max: 2},
+		},
+		BaseEffects: ChoiceEffect{MeterDeltas: map[Meter]int{MeterVisibility: 5}},
+		BaseCost:    Cost{Time: 1, Fatigue: 4},
+		Skill:       SkillNavigation,
+		Category:    "physical",
+		Exertion:    true,
+		Keywords:    []string{"scout", "peek", "survey", "recon"},
+	},
+	"organize": {
+		BaseOutcome: ChoiceOutcome{
+			StatMorale: {Min: 2, Max: 4},
+		},
+		BaseCost: Cost{Time: 1},
+		Skill:    SkillLeadership,
+		Category: "mental",
+		Keywords: []string{"organize", "sort", "arrange"},
+	},
+	"barricade": {
+		BaseOutcome: ChoiceOutcome{
+			StatFatigue: {Min: 6, Max: 9},
+		},
+		BaseEffects: ChoiceEffect{MeterDeltas: map[Meter]int{MeterNoise: 12}},
+		BaseCost:    Cost{Time: 1, Fatigue: 5},
+		Skill:       SkillSurvival,
+		Category:    "physical",
+		Exertion:    true,
+		Keywords:    []string{"barricade", "board", "secure"},
+	},
+	"craft": {
+		BaseOutcome: ChoiceOutcome{
+			StatFatigue: {Min: 4, Max: 7},
+			StatMorale:  {Min: 1, Max: 2},
+		},
+		BaseEffects: ChoiceEffect{MeterDeltas: map[Meter]int{MeterNoise: 5}},
+		BaseCost:    Cost{Time: 1, Fatigue: 4},
+		Skill:       SkillCrafting,
+		Category:    "technical",
+		Keywords:    []string{"craft", "improvise", "jury-rig", "rig up", "build"},
+	},
+	"diplomacy": {
+		BaseOutcome: ChoiceOutcome{
+			StatMorale: {Min: 2, Max: 4},
+		},
+		BaseCost: Cost{Time: 1},
+		Skill:    SkillDiplomacy,
+		Category: "social",
+		Keywords: []string{"negotiate", "parley", "talk to", "reason with"},
+	},
+	"observe": {
+		BaseOutcome: ChoiceOutcome{
+			StatFatigue: {Min: 3, Max: 5},
+			StatMorale:  {Min: 1, Max: 1},
+		},
+		BaseEffects: ChoiceEffect{MeterDeltas: map[Meter]int{MeterVisibility: -3}},
+		BaseCost:    Cost{Time: 1, Fatigue: 2},
+		Skill:       SkillNavigation,
+		Category:    "physical",
+		Keywords:    []string{"observe", "keep watch", "watch", "listen"},
+	},
+	"medicate": {
+		BaseOutcome: ChoiceOutcome{
+			StatFatigue: {Min: -3, Max: -1},
+			StatMorale:  {Min: 0, Max: 1},
+		},
+		BaseEffects: ChoiceEffect{MeterDeltas: map[Meter]int{MeterScent: 3}},
+		BaseCost:    Cost{Time: 1},
+		Skill:       SkillMedicine,
+		Category:    "rest",
+		Keywords:    []string{"treat my", "treat the", "treat wound", "bandage", "medicate", "first aid", "patch up", "splint", "antibiotic", "painkiller", "antiseptic", "disinfect"},
+	},
+	"travel": {
+		BaseOutcome: ChoiceOutcome{
+			StatFatigue: {Min: 6, Max: 10},
+			StatMorale:  {Min: 0, Max: 1},
+		},
+		BaseEffects: ChoiceEffect{MeterDeltas: map[Meter]int{MeterNoise: 3, MeterVisibility: 6, MeterScent: 5}},
+		BaseCost:    Cost{Time: 2, Fatigue: 5},
+		Skill:       SkillNavigation,
+		Category:    "physical",
+		Exertion:    true,
+		Keywords:    []string{"travel", "head to", "head for", "move on", "journey", "walk to", "drive to", "set out", "relocate"},
+	},
+	"trade": {
+		BaseOutcome: ChoiceOutcome{
+			StatHunger: {Min: -3, Max: -1},
+			StatMorale: {Min: 1, Max: 3},
+		},
+		BaseEffects: ChoiceEffect{MeterDeltas: map[Meter]int{MeterTrust: 2}},
+		BaseCost:    Cost{Time: 1},
+		Skill:       SkillNegotiation,
+		Category:    "social",
+		Keywords:    []string{"trade", "barter", "swap", "bargain"},
+	},
+	"fight": {
+		BaseOutcome: ChoiceOutcome{
+			StatHealth:  {Min: -6, Max: -2},
+			StatFatigue: {Min: 7, Max: 10},
+			StatMorale:  {Min: 1, Max: 3},
+		},
+		BaseEffects: ChoiceEffect{MeterDeltas: map[Meter]int{MeterNoise: 10, MeterScent: 5}},
+		BaseCost:    Cost{Time: 1, Fatigue: 6},
+		Skill:       SkillCombatMelee,
+		Category:    "physical",
+		Exertion:    true,
+		Keywords:    []string{"fight", "attack", "kill", "shoot", "ambush", "take down", "stab"},
+	},
+	"hide": {
+		BaseOutcome: ChoiceOutcome{
+			StatFatigue: {Min: -4, Max: -1},
+			StatMorale:  {Min: -2, Max: 0},
+		},
+		BaseEffects: ChoiceEffect{MeterDeltas: map[Meter]int{MeterNoise: -5, MeterVisibility: -8}},
+		BaseCost:    Cost{Time: 1},
+		Skill:       SkillStealth,
+		Category:    "stealth",
+		Keywords:    []string{"hide", "take cover", "lie low", "stay hidden"},
+	},
+	"hunt": {
+		BaseOutcome: ChoiceOutcome{
+			StatHunger:  {Min: -6, Max: -3},
+			StatFatigue: {Min: 5, Max: 8},
+		},
+		BaseEffects: ChoiceEffect{MeterDeltas: map[Meter]int{MeterNoise: 3, MeterScent: 5}},
+		BaseCost:    Cost{Time: 2, Fatigue: 4},
+		Skill:       SkillSurvival,
+		Category:    "physical",
+		Exertion:    true,
+		Keywords:    []string{"hunt", "set a trap", "set traps", "snare", "fish"},
+	},
+	"repair": {
+		BaseOutcome: ChoiceOutcome{
+			StatFatigue: {Min: 3, Max: 6},
+			StatMorale:  {Min: 1, Max: 3},
+		},
+		BaseEffects: ChoiceEffect{MeterDeltas: map[Meter]int{MeterFortificationIntegrity: 8, MeterNoise: 6}},
+		BaseCost:    Cost{Time: 1, Fatigue: 3},
+		Skill:       SkillMechanics,
+		Category:    "technical",
+		Keywords:    []string{"repair", "fix", "mend"},
+	},
+	"signal": {
+		BaseOutcome: ChoiceOutcome{
+			StatFatigue: {Min: 2, Max: 4},
+			StatMorale:  {Min: 2, Max: 4},
+		},
+		BaseEffects: ChoiceEffect{MeterDeltas: map[Meter]int{MeterSignalStrength: 10, MeterVisibility: 6, MeterNoise: 4}},
+		BaseCost:    Cost{Time: 1, Fatigue: 2},
+		Skill:       SkillCommunications,
+		Category:    "technical",
+		Keywords:    []string{"signal", "flare", "radio", "beacon", "call for help"},
+	},
+}
+
+// AllowedArchetypes returns the ordered list of archetypes supported by planner choices and
+// custom actions.
+func AllowedArchetypes() []string {
+	keys := make([]string, 0, len(archetypeProfiles))
+	for k := range archetypeProfiles {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// isHighExertionChoice reports whether a choice strains the survivor enough to matter on hard.
+func isHighExertionChoice(c Choice) bool {
+	if archetypeCategory(c.Archetype) == "rest" || c.Archetype == "organize" {
+		return false
+	}
+	if c.Cost.Fatigue >= 6 {
+		return true
+	}
+	return archetypeProfiles[c.Archetype].Exertion
+}
+
+// archetypeCategory classifies an archetype for condition and difficulty modifiers.
+func archetypeCategory(a string) string {
+	if a == "pause" {
+		return "rest"
+	}
+	if p, ok := archetypeProfiles[a]; ok {
+		return p.Category
+	}
+	return "general"
+}
+
+func relevantSkill(a string) Skill {
+	if p, ok := archetypeProfiles[a]; ok {
+		return p.Skill
+	}
+	return SkillSurvival
+}
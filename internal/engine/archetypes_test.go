@@ -0,0 +1,88 @@
+package engine
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestArchetypeVocabularyIsShared(t *testing.T) {
+	for _, a := range AllowedArchetypes() {
+		p := archetypeProfiles[a]
+		if p.Skill == "" || p.Category == "" || len(p.Keywords) == 0 {
+			t.Fatalf("archetype %s missing skill, category or keywords: %+v", a, p)
+		}
+		if !contains(customMatchOrder, a) {
+			t.Fatalf("archetype %s cannot be reached by custom actions", a)
+		}
+		if len(choiceLabels[a]) == 0 {
+			t.Fatalf("archetype %s has no planner labels", a)
+		}
+		if _, ok := effectBudgets[a]; !ok {
+			t.Fatalf("archetype %s has no effect budget", a)
+		}
+	}
+	if len(customMatchOrder) != len(archetypeProfiles) {
+		t.Fatalf("custom match order lists %d archetypes, profiles define %d", len(customMatchOrder), len(archetypeProfiles))
+	}
+}
+
+func TestTravelPlansAreAccepted(t *testing.T) {
+	c, err := buildChoiceFromPlan(catalogByID()["quiet_hour"], 0, PlannedChoice{Label: "Move out", Archetype: "travel", Risk: "moderate"})
+	if err != nil {
+		t.Fatalf("travel should be a supported archetype: %v", err)
+	}
+	if c.Cost.Time != 2 || !isHighExertionChoice(c) || relevantSkill("travel") != SkillNavigation {
+		t.Fatalf("unexpected travel profile: %+v", c)
+	}
+}
+
+func TestPlannedTimeIsBoundedPerArchetype(t *testing.T) {
+	bp := catalogByID()["quiet_hour"]
+	c, err := buildChoiceFromPlan(bp, 0, PlannedChoice{Label: "Sleep it off", Archetype: "rest", Cost: PlanCost{Time: 500}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Cost.Time != 3 {
+		t.Fatalf("expected a runaway rest clamped to 3 time units, got %d", c.Cost.Time)
+	}
+	c, _ = buildChoiceFromPlan(bp, 0, PlannedChoice{Label: "Move out", Archetype: "travel", Cost: PlanCost{Time: 500}})
+	if c.Cost.Time != 6 {
+		t.Fatalf("expected travel clamped to a day, got %d", c.Cost.Time)
+	}
+	c, _ = buildChoiceFromPlan(bp, 0, PlannedChoice{Label: "Nap", Archetype: "rest", Cost: PlanCost{Time: 2}})
+	if c.Cost.Time != 2 {
+		t.Fatalf("expected an in-range time kept, got %d", c.Cost.Time)
+	}
+}
+
+func TestCustomActionsReachNewArchetypes(t *testing.T) {
+	s := Survivor{Stats: Stats{Health: 100}, Location: LocationSuburb, Inventory: Inventory{Tools: []string{"wrench"}, FoodDays: 2, WaterLiters: 6}}
+	cases := map[string]string{
+		"fight off the infected at the gate": "fight",
+		"hide in the culvert until dusk":     "hide",
+		"hunt rabbits near the field":        "hunt",
+		"head to the industrial estate":      "travel",
+		"barter the wrench for water":        "trade",
+		"repair the fence":                   "repair",
+		"light a flare for the helicopter":   "signal",
+	}
+	for input, want := range cases {
+		c, ok, reason := ValidateCustomAction(input, s, 0, DifficultyStandard)
+		if !ok || c.Archetype != want {
+			t.Fatalf("%q: expected %s, got %q ok=%v (%s)", input, want, c.Archetype, ok, reason)
+		}
+	}
+	if _, ok, reason := ValidateCustomAction("trade for food", Survivor{}, 0, DifficultyStandard); ok || reason != "Nothing to trade" {
+		t.Fatalf("expected trade refused with nothing to offer, got ok=%v %q", ok, reason)
+	}
+}
+
+func TestHuntBringsBackFood(t *testing.T) {
+	seed, _ := NewRunSeed("hunt")
+	s := Survivor{Stats: Stats{Health: 100, Morale: 60}, Skills: map[Skill]int{SkillSurvival: 3}, Meters: baselineMeters(), Inventory: Inventory{FoodDays: 1, WaterLiters: 5}}
+	c := Choice{ID: "h", Archetype: "hunt", Risk: RiskLow, Cost: Cost{Time: 2}, Outcome: cloneOutcome(archetypeProfiles["hunt"].BaseOutcome)}
+	res := ApplyChoice(&s, c, DifficultyStandard, 0, seed.Stream(fmt.Sprintf("turn:%d", 0)))
+	if res.Supplies.FoodDays <= 0 {
+		t.Fatalf("expected a hunt to add food net of the time spent, got %+v", res.Supplies)
+	}
+}
@@ -0,0 +1,65 @@
+package engine
+
+// ArcProgress records how far a survivor has advanced through an event arc.
+type ArcProgress struct {
+	Step         int // last step that fired
+	LastSceneIdx int
+}
+
+func (h EventHistory) arcProgress(arcID string) (ArcProgress, bool) {
+	if h.Arcs == nil {
+		return ArcProgress{}, false
+	}
+	p, ok := h.Arcs[arcID]
+	return p, ok
+}
+
+// arcFinalStep is the highest step any event in the active catalog gives the arc.
+func arcFinalStep(arcID string) int {
+	final := 0
+	for _, ev := range eventCatalog {
+		if ev.ArcID == arcID && ev.ArcStep > final {
+			final = ev.ArcStep
+		}
+	}
+	return final
+}
+
+// RestoreArc puts back saved progress for an arc. Finished arcs are left out, as Record
+// leaves them, so their opener can fire again.
+func (h *EventHistory) RestoreArc(arcID string, p ArcProgress) {
+	if p.Step >= arcFinalStep(arcID) {
+		return
+	}
+	if h.Arcs == nil {
+		h.Arcs = make(map[string]ArcProgress)
+	}
+	h.Arcs[arcID] = p
+}
+
+// arcEligible reports whether an arc event may fire now. Opening steps need the arc to be
+// untouched or finished; progress is cleared when the final step fires. Later steps need
+// the previous step to have fired in an earlier scene. Events outside any arc are always
+// eligible.
+func (h EventHistory) arcEligible(bp EventBlueprint, sceneIdx int) bool {
+	if bp.ArcID == "" {
+		return true
+	}
+	p, started := h.arcProgress(bp.ArcID)
+	if bp.ArcStep <= 1 {
+		return !started
+	}
+	return started && p.Step == bp.ArcStep-1 && p.LastSceneIdx < sceneIdx
+}
+
+// arcSteps flattens arc progress for the planner snapshot.
+func (h EventHistory) arcSteps() map[string]int {
+	if len(h.Arcs) == 0 {
+		return nil
+	}
+	out := make(map[string]int, len(h.Arcs))
+	for id, p := range h.Arcs {
+		out[id] = p.Step
+	}
+	return out
+}
@@ -0,0 +1,92 @@
+package engine
+
+import "testing"
+
+func TestArcStepsUnlockInOrder(t *testing.T) {
+	survivor := testSurvivor("arc-order")
+	survivor.Environment.WorldDay = 10
+	survivor.Environment.LAD = 0
+	survivor.updateInfectionPresence()
+	catalog := catalogByID()
+
+	hist := EventHistory{}
+	events := availableEventBlueprints(&survivor, hist, 0)
+	if !hasEvent(events, "radio_distress") || hasEvent(events, "radio_locate_source") {
+		t.Fatalf("expected only the opening step before the arc starts")
+	}
+	hist.Record(catalog["radio_distress"], 0)
+	if hasEvent(availableEventBlueprints(&survivor, hist, 0), "radio_locate_source") {
+		t.Fatalf("follow-up should wait for a later scene")
+	}
+	events = availableEventBlueprints(&survivor, hist, 3)
+	if !hasEvent(events, "radio_locate_source") || hasEvent(events, "radio_distress") || hasEvent(events, "radio_rescue") {
+		t.Fatalf("expected step 2 only once the arc is open")
+	}
+	hist.Record(catalog["radio_locate_source"], 3)
+	events = availableEventBlueprints(&survivor, hist, 6)
+	if !hasEvent(events, "radio_rescue") || !hasEvent(events, "radio_ambush") {
+		t.Fatalf("expected both final branches to be eligible")
+	}
+	if snap := hist.snapshot(5); snap.Arcs["radio_distress"] != 2 {
+		t.Fatalf("expected snapshot to carry arc progress, got %+v", snap.Arcs)
+	}
+	hist.Record(catalog["radio_ambush"], 6)
+	events = availableEventBlueprints(&survivor, hist, 7)
+	if hasEvent(events, "radio_rescue") || hasEvent(events, "radio_locate_source") {
+		t.Fatalf("a finished arc should not offer its other branch or a follow-up")
+	}
+	if _, open := hist.Arcs["radio_distress"]; open {
+		t.Fatalf("expected the finished arc's progress to be cleared, got %+v", hist.Arcs)
+	}
+}
+
+func TestArcOpenerRepeatsAfterArcCompletes(t *testing.T) {
+	survivor := testSurvivor("arc-repeat")
+	survivor.Environment.WorldDay = 10
+	survivor.Environment.LAD = 0
+	survivor.updateInfectionPresence()
+	catalog := catalogByID()
+
+	hist := EventHistory{}
+	hist.Record(catalog["supply_convoy"], 0)
+	hist.Record(catalog["convoy_tracks"], 3)
+	if hasEvent(availableEventBlueprints(&survivor, hist, 5), "supply_convoy") {
+		t.Fatalf("an opener should not fire again while its arc is under way")
+	}
+	hist.Record(catalog["convoy_depot"], 6)
+	if hasEvent(availableEventBlueprints(&survivor, hist, 7), "convoy_tracks") {
+		t.Fatalf("a finished arc should not offer its follow-ups")
+	}
+	if !hasEvent(availableEventBlueprints(&survivor, hist, 7), "supply_convoy") {
+		t.Fatalf("expected the convoy sighting to be available again once its arc completed")
+	}
+	hist.Record(catalog["supply_convoy"], 7)
+	if !hasEvent(availableEventBlueprints(&survivor, hist, 10), "convoy_tracks") {
+		t.Fatalf("expected the reopened arc to continue from step 2")
+	}
+}
+
+func TestReloadedFinishedArcCanReopen(t *testing.T) {
+	survivor := testSurvivor("arc-reload")
+	survivor.Environment.WorldDay = 10
+	survivor.Environment.LAD = 0
+	survivor.updateInfectionPresence()
+	catalog := catalogByID()
+
+	hist := EventHistory{}
+	hist.Record(catalog["radio_distress"], 0)
+	hist.Record(catalog["radio_locate_source"], 3)
+	hist.Record(catalog["radio_rescue"], 6)
+
+	// a reload restores the newest saved step of each arc, as EventRepo.LoadHistory does
+	reloaded := EventHistory{Events: hist.Events}
+	reloaded.RestoreArc("radio_distress", ArcProgress{Step: 3, LastSceneIdx: 6})
+	if !hasEvent(availableEventBlueprints(&survivor, reloaded, 7), "radio_distress") {
+		t.Fatalf("expected the opener offered again after reloading a finished arc, got %+v", reloaded.Arcs)
+	}
+	midway := EventHistory{}
+	midway.RestoreArc("radio_distress", ArcProgress{Step: 2, LastSceneIdx: 3})
+	if !hasEvent(availableEventBlueprints(&survivor, midway, 7), "radio_rescue") {
+		t.Fatalf("expected an unfinished arc to resume after reload")
+	}
+}
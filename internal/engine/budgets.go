@@ -0,0 +1,266 @@
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// effectBudget bounds what a director may propose for one archetype. Conditions and meters
+// outside the lists are refused outright; counts and meter magnitudes are clamped.
+type effectBudget struct {
+	Inflicts   []Condition // conditions the work can plausibly cause
+	Clears     []Condition // conditions the work can plausibly relieve
+	Meters     []Meter     // meters the work can move
+	MeterCap   int         // largest absolute delta per meter
+	MaxAdds    int
+	MaxRemoves int
+	MaxGains   int
+	MaxLosses  int
+}
+
+var effectBudgets = map[string]effectBudget{
+	"rest": {
+		Clears:     []Condition{ConditionExhaustion, ConditionPain},
+		Meters:     []Meter{MeterNoise, MeterVisibility, MeterScent},
+		MeterCap:   5,
+		MaxRemoves: 1,
+	},
+	"forage": {
+		Inflicts:  []Condition{ConditionContamination, ConditionPoisoning, ConditionSprain, ConditionBleeding},
+		Meters:    []Meter{MeterNoise, MeterScent, MeterVisibility, MeterSupplyOutlook},
+		MeterCap:  10,
+		MaxAdds:   1,
+		MaxGains:  2,
+		MaxLosses: 1,
+	},
+	"scout": {
+		Inflicts: []Condition{ConditionSprain, ConditionBleeding, ConditionExhaustion},
+		Meters:   []Meter{MeterNoise, MeterVisibility, MeterSignalStrength},
+		MeterCap: 10,
+		MaxAdds:  1,
+		MaxGains: 1,
+	},
+	"organize": {
+		Meters:    []Meter{MeterSupplyBuffer, MeterSupplyOutlook, MeterCampVisibility},
+		MeterCap:  8,
+		MaxGains:  1,
+		MaxLosses: 1,
+	},
+	"barricade": {
+		Inflicts:  []Condition{ConditionBleeding, ConditionSprain},
+		Meters:    []Meter{MeterFortificationIntegrity, MeterNoise, MeterCampVisibility},
+		MeterCap:  15,
+		MaxAdds:   1,
+		MaxLosses: 1,
+	},
+	"craft": {
+		Inflicts:  []Condition{ConditionBurns, ConditionBleeding},
+		Meters:    []Meter{MeterNoise, MeterFortificationIntegrity, MeterSignalStrength},
+		MeterCap:  10,
+		MaxAdds:   1,
+		MaxGains:  1,
+		MaxLosses: 2,
+	},
+	"diplomacy": {
+		Meters:    []Meter{MeterTrust, MeterCommunitySentiment, MeterLeadershipTrust},
+		MeterCap:  10,
+		MaxGains:  1,
+		MaxLosses: 2,
+	},
+	"observe": {
+		Meters:   []Meter{MeterVisibility, MeterStealthProfile, MeterNoise},
+		MeterCap: 5,
+	},
+	"medicate": {
+		Clears:     []Condition{ConditionPain},
+		Meters:     []Meter{MeterScent},
+		MeterCap:   5,
+		MaxRemoves: 1,
+		MaxLosses:  1,
+	},
+	"travel": {
+		Inflicts: []Condition{ConditionSprain, ConditionExhaustion, ConditionDehydration},
+		Meters:   []Meter{MeterNoise, MeterVisibility, MeterScent},
+		MeterCap: 10,
+		MaxAdds:  1,
+		MaxGains: 1,
+	},
+	"trade": {
+		Meters:    []Meter{MeterTrust, MeterCommunitySentiment},
+		MeterCap:  10,
+		MaxGains:  2,
+		MaxLosses: 2,
+	},
+	"fight": {
+		Inflicts:  []Condition{ConditionBleeding, ConditionFracture, ConditionConcussion, ConditionPain},
+		Meters:    []Meter{MeterNoise, MeterVisibility, MeterScent, MeterPanicLevel},
+		MeterCap:  15,
+		MaxAdds:   2,
+		MaxGains:  1,
+		MaxLosses: 1,
+	},
+	"hide": {
+		Meters:   []Meter{MeterNoise, MeterVisibility, MeterScent, MeterStealthProfile, MeterPanicLevel},
+		MeterCap: 10,
+	},
+	"hunt": {
+		Inflicts: []Condition{ConditionSprain, ConditionBleeding},
+		Meters:   []Meter{MeterNoise, MeterScent, MeterSupplyOutlook},
+		MeterCap: 10,
+		MaxAdds:  1,
+		MaxGains: 1,
+	},
+	"repair": {
+		Inflicts:  []Condition{ConditionBurns, ConditionBleeding},
+		Meters:    []Meter{MeterFortificationIntegrity, MeterNoise},
+		MeterCap:  15,
+		MaxAdds:   1,
+		MaxLosses: 1,
+	},
+	"signal": {
+		Meters:    []Meter{MeterSignalStrength, MeterVisibility, MeterNoise, MeterCampVisibility},
+		MeterCap:  15,
+		MaxLosses: 1,
+	},
+}
+
+// budgetFor scales an archetype's budget by difficulty: easy runs allow an extra find and
+// relief, hard runs allow one fewer of each and one more inflicted condition.
+func budgetFor(archetype string, diff Difficulty) effectBudget {
+	b := effectBudgets[archetype]
+	switch diff {
+	case DifficultyEasy:
+		b.MaxGains++
+		if b.MaxRemoves > 0 {
+			b.MaxRemoves++
+		}
+	case DifficultyHard:
+		b.MaxGains = clampInt(b.MaxGains-1, 0, b.MaxGains)
+		b.MaxRemoves = clampInt(b.MaxRemoves-1, 0, b.MaxRemoves)
+		if b.MaxAdds > 0 {
+			b.MaxAdds++
+		}
+	}
+	return b
+}
+
+// EffectRejection explains why part of a director's proposed effect was dropped or clamped.
+type EffectRejection struct {
+	Field  string // add_conditions, remove_conditions, meter_deltas, gain_items, lose_items, hazards
+	Value  string
+	Reason string
+}
+
+func (r EffectRejection) String() string {
+	return fmt.Sprintf("%s %s: %s", r.Field, r.Value, r.Reason)
+}
+
+const maxItemNameLen = 40
+
+// boundProposedEffects keeps the parts of a proposal that fit the archetype's budget and
+// reports the rest. Item losses must name something the survivor carries.
+func boundProposedEffects(p ChoiceEffect, archetype string, diff Difficulty, s Survivor) (ChoiceEffect, []EffectRejection) {
+	b := budgetFor(archetype, diff)
+	var (
+		out      ChoiceEffect
+		rejected []EffectRejection
+	)
+	reject := func(field, value, reason string) {
+		rejected = append(rejected, EffectRejection{Field: field, Value: value, Reason: reason})
+	}
+	for _, c := range p.AddConditions {
+		switch {
+		case !c.Validate():
+			reject("add_conditions", string(c), "unknown condition")
+		case !contains(b.Inflicts, c):
+			reject("add_conditions", string(c), fmt.Sprintf("%s cannot cause it", archetype))
+		case len(out.AddConditions) >= b.MaxAdds:
+			reject("add_conditions", string(c), fmt.Sprintf("over budget of %d", b.MaxAdds))
+		case !contains(out.AddConditions, c):
+			out.AddConditions = append(out.AddConditions, c)
+		}
+	}
+	for _, c := range p.RemoveConditions {
+		switch {
+		case !c.Validate():
+			reject("remove_conditions", string(c), "unknown condition")
+		case !contains(b.Clears, c):
+			reject("remove_conditions", string(c), fmt.Sprintf("%s cannot relieve it", archetype))
+		case len(out.RemoveConditions) >= b.MaxRemoves:
+			reject("remove_conditions", string(c), fmt.Sprintf("over budget of %d", b.MaxRemoves))
+		case !contains(out.RemoveConditions, c):
+			out.RemoveConditions = append(out.RemoveConditions, c)
+		}
+	}
+	for _, m := range AllMeters {
+		delta, ok := p.MeterDeltas[m]
+		if !ok || delta == 0 {
+			continue
+		}
+		if !contains(b.Meters, m) {
+			reject("meter_deltas", string(m), fmt.Sprintf("%s cannot move it", archetype))
+			continue
+		}
+		if bounded := clampInt(delta, -b.MeterCap, b.MeterCap); bounded != delta {
+			reject("meter_deltas", string(m), fmt.Sprintf("%+d clamped to %+d", delta, bounded))
+			delta = bounded
+		}
+		if out.MeterDeltas == nil {
+			out.MeterDeltas = map[Meter]int{}
+		}
+		out.MeterDeltas[m] = delta
+	}
+	for _, m := range sortedKeys(p.MeterDeltas) {
+		if !m.Validate() {
+			reject("meter_deltas", string(m), "unknown meter")
+		}
+	}
+	for _, raw := range p.GainItems {
+		item := strings.ToLower(strings.TrimSpace(raw))
+		switch {
+		case item == "" || len(item) > maxItemNameLen:
+			reject("gain_items", raw, "invalid item name")
+		case len(out.GainItems) >= b.MaxGains:
+			reject("gain_items", item, fmt.Sprintf("over budget of %d", b.MaxGains))
+		default:
+			out.GainItems = append(out.GainItems, item)
+		}
+	}
+	carried := carriedItems(s.Inventory)
+	for _, raw := range p.LoseItems {
+		item := strings.ToLower(strings.TrimSpace(raw))
+		switch {
+		case !contains(carried, item):
+			reject("lose_items", raw, "not carried")
+		case len(out.LoseItems) >= b.MaxLosses:
+			reject("lose_items", item, fmt.Sprintf("over budget of %d", b.MaxLosses))
+		default:
+			removeString(&carried, item)
+			out.LoseItems = append(out.LoseItems, item)
+		}
+	}
+	for _, c := range sortedKeys(p.Hazards) {
+		reject("hazards", string(c), "hazards come from event blueprints only")
+	}
+	return out, rejected
+}
+
+func sortedKeys[K ~string, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+func clampInt(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
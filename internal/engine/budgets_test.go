@@ -0,0 +1,82 @@
+package engine
+
+import (
+	"context"
+	"testing"
+)
+
+func TestBoundProposedEffectsClampsAndRejects(t *testing.T) {
+	s := Survivor{Inventory: Inventory{Tools: []string{"crowbar"}}}
+	proposal := ChoiceEffect{
+		AddConditions:    []Condition{ConditionContamination, ConditionRadiation},
+		RemoveConditions: []Condition{ConditionInfection},
+		MeterDeltas:      map[Meter]int{MeterNoise: 40, MeterTrust: 5},
+		GainItems:        []string{"Antibiotics", "canned beans", "flare gun"},
+		LoseItems:        []string{"crowbar", "rifle"},
+		Hazards:          map[Condition]int{ConditionBleeding: 50},
+	}
+	got, rejected := boundProposedEffects(proposal, "forage", DifficultyStandard, s)
+	if len(got.AddConditions) != 1 || got.AddConditions[0] != ConditionContamination {
+		t.Fatalf("expected only contamination accepted, got %v", got.AddConditions)
+	}
+	if len(got.RemoveConditions) != 0 {
+		t.Fatalf("forage should not relieve infection: %v", got.RemoveConditions)
+	}
+	if got.MeterDeltas[MeterNoise] != 10 || got.MeterDeltas[MeterTrust] != 0 {
+		t.Fatalf("expected noise clamped to 10 and trust refused, got %v", got.MeterDeltas)
+	}
+	if len(got.GainItems) != 2 || got.GainItems[0] != "antibiotics" {
+		t.Fatalf("expected two normalized gains, got %v", got.GainItems)
+	}
+	if len(got.LoseItems) != 1 || got.LoseItems[0] != "crowbar" {
+		t.Fatalf("expected only the carried item lost, got %v", got.LoseItems)
+	}
+	if len(got.Hazards) != 0 {
+		t.Fatalf("director hazards must be refused")
+	}
+	// radiation, infection, noise clamp, trust, flare gun, rifle, bleeding hazard
+	if len(rejected) != 7 {
+		t.Fatalf("expected 7 rejections with reasons, got %d: %v", len(rejected), rejected)
+	}
+	for _, r := range rejected {
+		if r.Reason == "" {
+			t.Fatalf("rejection without reason: %+v", r)
+		}
+	}
+}
+
+func TestEffectBudgetScalesWithDifficulty(t *testing.T) {
+	proposal := ChoiceEffect{GainItems: []string{"a", "b", "c"}}
+	easy, _ := boundProposedEffects(proposal, "forage", DifficultyEasy, Survivor{})
+	hard, _ := boundProposedEffects(proposal, "forage", DifficultyHard, Survivor{})
+	if len(easy.GainItems) != 3 || len(hard.GainItems) != 1 {
+		t.Fatalf("expected easy=3 hard=1 gains, got easy=%d hard=%d", len(easy.GainItems), len(hard.GainItems))
+	}
+}
+
+func TestGenerateChoicesRecordsBoundedEffects(t *testing.T) {
+	seed, _ := NewRunSeed("planner-effects")
+	survivor := testSurvivor("planner-effects")
+	survivor.Environment.WorldDay = 5
+	survivor.Environment.LAD = 5
+	survivor.updateInfectionPresence()
+	planner := &stubPlanner{plan: DirectorPlan{
+		EventID: "checkpoint_tension",
+		Choices: []PlannedChoice{
+			{Label: "Raid the aid tent", Archetype: "forage", Risk: "moderate",
+				Effects: ChoiceEffect{GainItems: []string{"antibiotics"}, MeterDeltas: map[Meter]int{MeterNoise: 25}}},
+			{Label: "Wait it out", Archetype: "observe", Risk: "low",
+				Effects: ChoiceEffect{GainItems: []string{"rifle"}}},
+		},
+	}}
+	choices, _, err := GenerateChoices(context.Background(), planner, seed.Stream("choices"), &survivor, EventHistory{}, 3)
+	if err != nil {
+		t.Fatalf("GenerateChoices: %v", err)
+	}
+	if !contains(choices[0].Effects.GainItems, "antibiotics") || choices[0].Effects.MeterDeltas[MeterNoise] != archetypeProfiles["forage"].BaseEffects.MeterDeltas[MeterNoise]+10 {
+		t.Fatalf("expected bounded effects recorded on the choice, got %+v", choices[0].Effects)
+	}
+	if len(choices[1].Effects.GainItems) != 0 || len(choices[1].Rejected) != 1 {
+		t.Fatalf("expected observe gain rejected, got %+v / %v", choices[1].Effects, choices[1].Rejected)
+	}
+}
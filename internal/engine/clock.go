@@ -0,0 +1,37 @@
+package engine
+
+// timeOfDaySegments lists the time-of-day segments in order; one Cost.Time unit advances one segment.
+var timeOfDaySegments = []string{"pre-dawn", "morning", "midday", "afternoon", "evening", "night"}
+
+func timeOfDayIndex(tod string) int {
+	for i, seg := range timeOfDaySegments {
+		if seg == tod {
+			return i
+		}
+	}
+	return 0
+}
+
+// advanceClock moves the survivor through time-of-day segments and rolls over world days.
+// Returns the number of day boundaries crossed.
+func advanceClock(s *Survivor, w *World, units int) int {
+	if s == nil || units <= 0 {
+		return 0
+	}
+	idx := timeOfDayIndex(s.Environment.TimeOfDay) + units
+	days := idx / len(timeOfDaySegments)
+	s.Environment.TimeOfDay = timeOfDaySegments[idx%len(timeOfDaySegments)]
+	if days == 0 {
+		return 0
+	}
+	day := s.Environment.WorldDay + days
+	if w != nil {
+		for w.CurrentDay < day {
+			w.AdvanceDay()
+		}
+	}
+	s.SyncEnvironmentDay(day)
+	w.SyncWeather(s)
+	w.SyncInfection(s)
+	return days
+}
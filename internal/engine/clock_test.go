@@ -0,0 +1,25 @@
+package engine
+
+import "testing"
+
+func TestClockAdvancesSegmentsAndDays(t *testing.T) {
+	seed, _ := NewRunSeed("clock")
+	w := NewWorld(seed, "1.0.0")
+	s := Survivor{Stats: Stats{Health: 100}, Meters: baselineMeters(), Inventory: Inventory{FoodDays: 3, WaterLiters: 9}}
+	s.Environment.TimeOfDay = "evening"
+	s.Environment.LAD = 1
+	res := ApplyChoice(&s, Choice{ID: "c", Cost: Cost{Time: 1}}, DifficultyStandard, 0, nil, WithWorld(w))
+	if s.Environment.TimeOfDay != "night" || res.DaysElapsed != 0 {
+		t.Fatalf("expected night on the same day, got %s (days=%d)", s.Environment.TimeOfDay, res.DaysElapsed)
+	}
+	res = ApplyChoice(&s, Choice{ID: "c", Cost: Cost{Time: 2}}, DifficultyStandard, 1, nil, WithWorld(w))
+	if s.Environment.TimeOfDay != "morning" || res.DaysElapsed != 1 {
+		t.Fatalf("expected rollover into morning, got %s (days=%d)", s.Environment.TimeOfDay, res.DaysElapsed)
+	}
+	if w.CurrentDay != 1 || s.Environment.WorldDay != 1 {
+		t.Fatalf("expected world and survivor on day 1, got world=%d survivor=%d", w.CurrentDay, s.Environment.WorldDay)
+	}
+	if !s.Environment.Infected {
+		t.Fatalf("expected infection presence to sync at LAD")
+	}
+}
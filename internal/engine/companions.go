@@ -0,0 +1,218 @@
+package engine
+
+import "fmt"
+
+// CompanionStatus tracks whether a companion is still with the group.
+type CompanionStatus string
+
+const (
+	CompanionActive CompanionStatus = "active"
+	CompanionDead   CompanionStatus = "dead"
+	CompanionLeft   CompanionStatus = "left"
+)
+
+// Companion is a named member of the survivor's group. Companions eat from the shared stores,
+// lend their skills to group work and can be hurt, killed or walk away.
+type Companion struct {
+	Name       string          `json:"name"`
+	Profession string          `json:"profession"`
+	Skills     map[Skill]int   `json:"skills"`
+	Health     int             `json:"health"`
+	Morale     int             `json:"morale"`
+	Loyalty    int             `json:"loyalty"`
+	Status     CompanionStatus `json:"status"`
+}
+
+// CompanionEvent records something that happened to a companion during a choice.
+type CompanionEvent struct {
+	Name   string `json:"name"`
+	Kind   string `json:"kind"`             // hurt | died | left | joined
+	Health int    `json:"health,omitempty"` // health lost when hurt
+}
+
+const (
+	maxGroupSize        = 5  // survivor plus four companions
+	companionShortage   = 3  // health lost per choice while the shared stores are empty
+	companionLeaveAt    = 15 // loyalty at or below which an unhappy companion walks away
+	companionUnhappyAt  = 30 // morale below which loyalty erodes and leaving is possible
+	companionDeathBlow  = 10 // survivor morale lost when a companion dies
+	companionRecruitPct = 20 // chance a successful diplomacy or trade brings in a new companion
+)
+
+// newCompanion rolls a companion from the profession templates.
+func newCompanion(stream *Stream) Companion {
+	prof := pickProfession(stream.Child("profession"))
+	skills := baselineSkills()
+	for sk, lvl := range prof.SkillBoosts {
+		skills[sk] = lvl
+	}
+	nameStream := stream.Child("name")
+	return Companion{
+		Name:       randomName(nameStream) + " " + randomSurname(nameStream),
+		Profession: prof.Name,
+		Skills:     skills,
+		Health:     Clamp(randIn(stream.Child("health"), prof.HealthRange)),
+		Morale:     Clamp(randIn(stream.Child("morale"), prof.MoraleRange)),
+		Loyalty:    40 + stream.Child("loyalty").Intn(41),
+		Status:     CompanionActive,
+	}
+}
+
+// newCompanions rolls n companions with distinct names, avoiding taken ones.
+func newCompanions(stream *Stream, n int, taken ...string) []Companion {
+	out := make([]Companion, 0, n)
+	for i := 0; len(out) < n && i < n*10; i++ {
+		c := newCompanion(stream.Child(fmt.Sprintf("companion:%d", i)))
+		if contains(taken, c.Name) {
+			continue
+		}
+		taken = append(taken, c.Name)
+		out = append(out, c)
+	}
+	return out
+}
+
+// ActiveCompanions returns the companions still with the group.
+func (s Survivor) ActiveCompanions() []Companion {
+	var out []Companion
+	for _, c := range s.Companions {
+		if c.Status == CompanionActive {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// AddCompanion brings a companion into the group. It fails when the group is full or a
+// companion of the same name has already travelled with the survivor.
+func (s *Survivor) AddCompanion(c Companion) error {
+	if 1+len(s.ActiveCompanions()) >= maxGroupSize {
+		return fmt.Errorf("group is full")
+	}
+	for _, existing := range s.Companions {
+		if existing.Name == c.Name {
+			return fmt.Errorf("%s has already been with the group", c.Name)
+		}
+	}
+	c.Status = CompanionActive
+	s.Companions = append(s.Companions, c)
+	s.syncGroup()
+	return nil
+}
+
+// syncGroup keeps GroupSize in step with the active companions and moves the survivor
+// between Solo, Duo and SmallGroup as people join or leave. Other group types are kept.
+func (s *Survivor) syncGroup() {
+	s.GroupSize = 1 + len(s.ActiveCompanions())
+	switch s.Group {
+	case GroupSolo, GroupDuo, GroupSmallGroup, "":
+	default:
+		return
+	}
+	switch {
+	case s.GroupSize == 1:
+		s.Group = GroupSolo
+	case s.GroupSize == 2:
+		s.Group = GroupDuo
+	default:
+		s.Group = GroupSmallGroup
+	}
+}
+
+// groupSkill is the best level the group brings to a skill: the survivor's own, or an
+// active companion's if higher.
+func groupSkill(s Survivor, sk Skill) int {
+	best := s.Skills[sk]
+	for _, c := range s.ActiveCompanions() {
+		if c.Skills[sk] > best {
+			best = c.Skills[sk]
+		}
+	}
+	return best
+}
+
+// tendCompanions moves each active companion through the aftermath of a choice: they go
+// hungry with the survivor, share in mishaps and failed exertion, track the group's mood and
+// may die, leave, or be joined by a newcomer.
+func tendCompanions(s *Survivor, c Choice, res Resolution, stream *Stream) []CompanionEvent {
+	if s == nil {
+		return nil
+	}
+	var events []CompanionEvent
+	active := make([]int, 0, len(s.Companions))
+	for i, comp := range s.Companions {
+		if comp.Status == CompanionActive {
+			active = append(active, i)
+		}
+	}
+	if len(active) > 0 && (res.Mishap != "" || (res.Check == CheckFailure && isHighExertionChoice(c) && c.Risk != RiskLow)) {
+		i := active[stream.Child("hurt").Intn(len(active))]
+		harm := 8 + stream.Child("harm").Intn(13)
+		s.Companions[i].Health = Clamp(s.Companions[i].Health - harm)
+		events = append(events, CompanionEvent{Name: s.Companions[i].Name, Kind: "hurt", Health: harm})
+	}
+	short := s.Inventory.FoodDays <= 0 || s.Inventory.WaterLiters <= 0
+	for _, i := range active {
+		comp := &s.Companions[i]
+		if short {
+			comp.Health = Clamp(comp.Health - companionShortage)
+			comp.Morale = Clamp(comp.Morale - 5)
+			comp.Loyalty = Clamp(comp.Loyalty - 3)
+		}
+		if c.Archetype == "rest" || c.Archetype == "medicate" {
+			comp.Health = Clamp(comp.Health + 3)
+		}
+		comp.Morale = Clamp(comp.Morale + res.Delta.Morale)
+		switch res.Check {
+		case CheckSuccess:
+			comp.Loyalty = Clamp(comp.Loyalty + 2)
+		case CheckFailure:
+			comp.Loyalty = Clamp(comp.Loyalty - 2)
+		}
+		if comp.Morale < companionUnhappyAt {
+			comp.Loyalty = Clamp(comp.Loyalty - 4)
+		}
+		switch {
+		case comp.Health <= 0:
+			comp.Status = CompanionDead
+			events = append(events, CompanionEvent{Name: comp.Name, Kind: "died"})
+		case comp.Loyalty <= companionLeaveAt && comp.Morale < companionUnhappyAt:
+			// a leaver takes their share of what is left
+			share := float64(groupHeadcount(*s))
+			s.Inventory.FoodDays = roundTenth(s.Inventory.FoodDays - s.Inventory.FoodDays/share)
+			s.Inventory.WaterLiters = roundTenth(s.Inventory.WaterLiters - s.Inventory.WaterLiters/share)
+			comp.Status = CompanionLeft
+			events = append(events, CompanionEvent{Name: comp.Name, Kind: "left"})
+		}
+		s.syncGroup()
+	}
+	if (c.Archetype == "diplomacy" || c.Archetype == "trade") && res.Check == CheckSuccess && stream.Child("recruit").Intn(100) < companionRecruitPct {
+		taken := make([]string, 0, len(s.Companions)+1)
+		for _, comp := range s.Companions {
+			taken = append(taken, comp.Name)
+		}
+		taken = append(taken, s.Name)
+		for _, recruit := range newCompanions(stream.Child("recruits"), 1, taken...) {
+			if s.AddCompanion(recruit) == nil {
+				events = append(events, CompanionEvent{Name: recruit.Name, Kind: "joined"})
+			}
+		}
+	}
+	s.syncGroup()
+	return events
+}
+
+// companionNarrative summarises the active companions for the narrator.
+func companionNarrative(s Survivor) []map[string]any {
+	var out []map[string]any
+	for _, c := range s.ActiveCompanions() {
+		out = append(out, map[string]any{
+			"name":       c.Name,
+			"profession": c.Profession,
+			"health":     c.Health,
+			"morale":     c.Morale,
+			"loyalty":    c.Loyalty,
+		})
+	}
+	return out
+}
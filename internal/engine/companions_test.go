@@ -0,0 +1,115 @@
+package engine
+
+import (
+	"fmt"
+	"testing"
+)
+
+// withCompanions brings n freshly rolled companions into a test survivor's group.
+func withCompanions(n int) func(*Survivor) {
+	return func(s *Survivor) {
+		for _, c := range newCompanions(newStream(SeedFromString("companions")), n, s.Name) {
+			if err := s.AddCompanion(c); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+func TestGenericGroupsHaveNamedCompanions(t *testing.T) {
+	seed, _ := NewRunSeed("companions")
+	var s Survivor
+	for i := 0; i < 50 && s.Group != GroupSmallGroup; i++ {
+		s = NewGenericSurvivor(seed.Stream(fmt.Sprintf("sv:%d", i)), seed, 0, nil)
+	}
+	if s.Group != GroupSmallGroup {
+		t.Fatalf("expected a small group among generated survivors")
+	}
+	active := s.ActiveCompanions()
+	if len(active) != s.GroupSize-1 || len(active) < 2 {
+		t.Fatalf("expected %d companions for a group of %d, got %d", s.GroupSize-1, s.GroupSize, len(active))
+	}
+	names := map[string]bool{s.Name: true}
+	for _, c := range active {
+		if c.Name == "" || c.Profession == "" || c.Health <= 0 || c.Loyalty <= 0 {
+			t.Fatalf("expected a fully rolled companion, got %+v", c)
+		}
+		if names[c.Name] {
+			t.Fatalf("duplicate name %q in the group", c.Name)
+		}
+		names[c.Name] = true
+	}
+	if _, ok := s.NarrativeState()["companions"]; !ok {
+		t.Fatalf("expected companions in the narrative state")
+	}
+}
+
+func TestCompanionSkillsCarryGroupChoices(t *testing.T) {
+	s := testSurvivor("companions", withCompanions(3))
+	s.Skills[SkillMedicine] = 0
+	for i := range s.Companions {
+		s.Companions[i].Skills[SkillMedicine] = 0
+	}
+	if groupSkill(s, SkillMedicine) != 0 {
+		t.Fatalf("expected no medicine skill in the group")
+	}
+	s.Companions[0].Skills[SkillMedicine] = 4
+	if groupSkill(s, SkillMedicine) != 4 {
+		t.Fatalf("expected a companion's medicine to count for the group")
+	}
+	s.Companions[0].Status = CompanionLeft
+	if groupSkill(s, SkillMedicine) != 0 {
+		t.Fatalf("expected departed companions to stop contributing")
+	}
+}
+
+func TestCompanionsDieAndLeaveChangingGroupSize(t *testing.T) {
+	s := testSurvivor("companions", withCompanions(3))
+	size := s.GroupSize
+	morale := s.Stats.Morale
+	s.Companions[0].Health = 2
+	s.Inventory.FoodDays = 0
+	res := ApplyChoice(&s, Choice{Archetype: "organize", Risk: RiskLow, Cost: Cost{Time: 1}}, DifficultyStandard, 1, newStream(SeedFromString("grief")))
+	if s.Companions[0].Status != CompanionDead || s.GroupSize != size-1 {
+		t.Fatalf("expected the starving companion to die and the group to shrink, got %+v size %d", s.Companions[0], s.GroupSize)
+	}
+	if len(res.Companions) == 0 || res.Companions[0].Kind != "died" || s.Stats.Morale >= morale {
+		t.Fatalf("expected the death recorded and felt, got %+v (morale %d -> %d)", res.Companions, morale, s.Stats.Morale)
+	}
+
+	s.Inventory.FoodDays, s.Inventory.WaterLiters = 10, 30
+	s.Companions[1].Loyalty, s.Companions[1].Morale = 5, 10
+	size = s.GroupSize
+	events := tendCompanions(&s, Choice{Archetype: "rest"}, Resolution{}, newStream(SeedFromString("leave")))
+	if s.Companions[1].Status != CompanionLeft || s.GroupSize != size-1 {
+		t.Fatalf("expected the disloyal companion to leave, got %+v", s.Companions[1])
+	}
+	if len(events) != 1 || events[0].Kind != "left" || s.Inventory.FoodDays >= 10 {
+		t.Fatalf("expected a leaver to take their share, got %v with %.1f food", events, s.Inventory.FoodDays)
+	}
+}
+
+func TestAddCompanionGrowsTheGroup(t *testing.T) {
+	seed, _ := NewRunSeed("recruit")
+	s := testSurvivor("recruit")
+	if err := s.AddCompanion(newCompanion(seed.Stream("c1"))); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if s.Group != GroupDuo || s.GroupSize != 2 {
+		t.Fatalf("expected a solo survivor to become a duo, got %s/%d", s.Group, s.GroupSize)
+	}
+	if err := s.AddCompanion(s.Companions[0]); err == nil {
+		t.Fatalf("expected a repeat companion to be refused")
+	}
+	for _, c := range newCompanions(seed.Stream("more"), 3, s.Name, s.Companions[0].Name) {
+		if err := s.AddCompanion(c); err != nil {
+			t.Fatalf("add: %v", err)
+		}
+	}
+	if s.GroupSize != maxGroupSize || s.Group != GroupSmallGroup {
+		t.Fatalf("expected a full small group, got %s/%d", s.Group, s.GroupSize)
+	}
+	if err := s.AddCompanion(newCompanion(seed.Stream("extra"))); err == nil {
+		t.Fatalf("expected a full group to refuse newcomers")
+	}
+}
@@ -0,0 +1,242 @@
+package engine
+
+import (
+	_ "embed"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed conditions.yml
+var defaultConditionRules []byte
+
+// conditionClause compares one stat or meter against a value.
+type conditionClause struct {
+	Stat  StatKey `yaml:"stat"`
+	Meter Meter   `yaml:"meter"`
+	Op    string  `yaml:"op"`
+	Value int     `yaml:"value"`
+}
+
+// scaledValue is a per-difficulty number; a YAML scalar applies to every difficulty.
+type scaledValue map[Difficulty]int
+
+func (v *scaledValue) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		var n int
+		if err := node.Decode(&n); err != nil {
+			return err
+		}
+		*v = scaledValue{DifficultyEasy: n, DifficultyStandard: n, DifficultyHard: n}
+		return nil
+	}
+	m := map[Difficulty]int{}
+	if err := node.Decode(&m); err != nil {
+		return err
+	}
+	*v = m
+	return nil
+}
+
+func (v scaledValue) at(diff Difficulty) int {
+	if n, ok := v[diff]; ok {
+		return n
+	}
+	return v[DifficultyStandard]
+}
+
+type conditionTickRule struct {
+	When   []conditionClause       `yaml:"when"`
+	Stats  map[StatKey]scaledValue `yaml:"stats"`
+	Meters map[Meter]int           `yaml:"meters"`
+}
+
+// conditionEscalation adds a linked condition once severity reaches a threshold.
+type conditionEscalation struct {
+	To         Condition `yaml:"to"`
+	AtSeverity int       `yaml:"at_severity"`
+}
+
+type conditionRule struct {
+	Onset       []conditionClause     `yaml:"onset"`
+	Ticks       []conditionTickRule   `yaml:"ticks"`
+	Remove      []conditionClause     `yaml:"remove"`
+	ResetMeters []Meter               `yaml:"reset_meters"`
+	IdleReset   []Meter               `yaml:"idle_reset"`
+	WorsenEvery int                   `yaml:"worsen_every"`
+	Escalates   []conditionEscalation `yaml:"escalates"`
+}
+
+var conditionRules = mustParseConditionRules(defaultConditionRules)
+
+func mustParseConditionRules(data []byte) map[Condition]conditionRule {
+	rules, err := parseConditionRules(data)
+	if err != nil {
+		panic(fmt.Sprintf("engine: invalid embedded condition rules: %v", err))
+	}
+	return rules
+}
+
+// LoadConditionRules replaces the active condition rules with a designer-supplied YAML document.
+func LoadConditionRules(data []byte) error {
+	rules, err := parseConditionRules(data)
+	if err != nil {
+		return err
+	}
+	conditionRules = rules
+	return nil
+}
+
+func parseConditionRules(data []byte) (map[Condition]conditionRule, error) {
+	raw := map[string]conditionRule{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	rules := make(map[Condition]conditionRule, len(raw))
+	for name, rule := range raw {
+		cond, ok := ParseCondition(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown condition %q", name)
+		}
+		if err := validateConditionRule(rule); err != nil {
+			return nil, fmt.Errorf("condition %s: %w", name, err)
+		}
+		for _, esc := range rule.Escalates {
+			if esc.To == cond {
+				return nil, fmt.Errorf("condition %s: escalates into itself", name)
+			}
+		}
+		rules[cond] = rule
+	}
+	for _, cond := range AllConditions {
+		if _, ok := rules[cond]; !ok {
+			return nil, fmt.Errorf("missing rule for condition %q", cond)
+		}
+	}
+	return rules, nil
+}
+
+func validateConditionRule(rule conditionRule) error {
+	clauses := append(append([]conditionClause{}, rule.Onset...), rule.Remove...)
+	for _, tick := range rule.Ticks {
+		clauses = append(clauses, tick.When...)
+		for key := range tick.Stats {
+			if !validStatKey(key) {
+				return fmt.Errorf("unknown stat %q", key)
+			}
+		}
+		for m := range tick.Meters {
+			if !m.Validate() {
+				return fmt.Errorf("unknown meter %q", m)
+			}
+		}
+	}
+	for _, c := range clauses {
+		if (c.Stat == "") == (c.Meter == "") {
+			return fmt.Errorf("clause must name exactly one stat or meter")
+		}
+		if c.Stat != "" && !validStatKey(c.Stat) {
+			return fmt.Errorf("unknown stat %q", c.Stat)
+		}
+		if c.Meter != "" && !c.Meter.Validate() {
+			return fmt.Errorf("unknown meter %q", c.Meter)
+		}
+		switch c.Op {
+		case ">=", "<=", ">", "<", "==":
+		default:
+			return fmt.Errorf("unknown op %q", c.Op)
+		}
+	}
+	for _, m := range append(append([]Meter{}, rule.ResetMeters...), rule.IdleReset...) {
+		if !m.Validate() {
+			return fmt.Errorf("unknown meter %q", m)
+		}
+	}
+	if rule.WorsenEvery < 0 {
+		return fmt.Errorf("worsen_every must not be negative")
+	}
+	for _, esc := range rule.Escalates {
+		if !esc.To.Validate() {
+			return fmt.Errorf("unknown escalation target %q", esc.To)
+		}
+		if esc.AtSeverity < 1 || esc.AtSeverity > maxConditionSeverity {
+			return fmt.Errorf("escalation to %s: at_severity must be 1-%d", esc.To, maxConditionSeverity)
+		}
+	}
+	return nil
+}
+
+func validStatKey(k StatKey) bool {
+	switch k {
+	case StatHealth, StatHunger, StatThirst, StatFatigue, StatMorale:
+		return true
+	default:
+		return false
+	}
+}
+
+func statValue(st Stats, k StatKey) int {
+	switch k {
+	case StatHealth:
+		return st.Health
+	case StatHunger:
+		return st.Hunger
+	case StatThirst:
+		return st.Thirst
+	case StatFatigue:
+		return st.Fatigue
+	case StatMorale:
+		return st.Morale
+	default:
+		return 0
+	}
+}
+
+func addStatValue(st *Stats, k StatKey, v int) {
+	switch k {
+	case StatHealth:
+		st.Health += v
+	case StatHunger:
+		st.Hunger += v
+	case StatThirst:
+		st.Thirst += v
+	case StatFatigue:
+		st.Fatigue += v
+	case StatMorale:
+		st.Morale += v
+	}
+}
+
+func (c conditionClause) holds(s *Survivor) bool {
+	v := 0
+	if c.Stat != "" {
+		v = statValue(s.Stats, c.Stat)
+	} else {
+		v = s.Meters[c.Meter]
+	}
+	switch c.Op {
+	case ">=":
+		return v >= c.Value
+	case "<=":
+		return v <= c.Value
+	case ">":
+		return v > c.Value
+	case "<":
+		return v < c.Value
+	default:
+		return v == c.Value
+	}
+}
+
+// allHold reports whether every clause holds; an empty list never holds.
+func allHold(s *Survivor, clauses []conditionClause) bool {
+	if len(clauses) == 0 {
+		return false
+	}
+	for _, c := range clauses {
+		if !c.holds(s) {
+			return false
+		}
+	}
+	return true
+}
@@ -2,12 +2,14 @@ package engine
 
 import "math"
 
+const maxConditionSeverity = 5
+
 type environmentSnapshot struct {
 	temp TempBand
 	loc  LocationType
 }
 
-func advanceConditions(s *Survivor, diff Difficulty, choice Choice, lastDelta Stats) conditionOutcome {
+func advanceConditions(s *Survivor, diff Difficulty, choice Choice, lastDelta Stats, turn int) conditionOutcome {
 	out := conditionOutcome{}
 	if s == nil {
 		return out
@@ -16,14 +18,15 @@ func advanceConditions(s *Survivor, diff Difficulty, choice Choice, lastDelta St
 		s.Meters = make(map[Meter]int)
 	}
 	updateThirstMeters(s, lastDelta)
+	updateHungerMeters(s)
 	updateTemperatureMeters(s)
 	updateFeverMeters(s, choice)
-	applyDehydrationTriggers(s, &out)
-	applyHypothermiaTriggers(s, &out)
-	applyExhaustionTriggers(s, &out)
-	applyFeverRemoval(s, &out)
+	updateRestMeters(s, choice)
+	applyConditionOnsets(s, &out)
 	applyConditionRemovals(s, &out)
+	progressConditions(s, turn, &out)
 	out.Delta = addStats(out.Delta, conditionTick(s, diff))
+	out.Delta.Thirst += heatThirstDrain(s.Environment, diff)
 	return out
 }
 
@@ -48,6 +51,18 @@ func updateThirstMeters(s *Survivor, lastDelta Stats) {
 	s.Meters[MeterHydrationRecovery] = recovery
 }
 
+func updateHungerMeters(s *Survivor) {
+	streak := s.Meters[MeterHungerStreak]
+	if s.Stats.Hunger >= 80 {
+		if streak < math.MaxInt32 {
+			streak++
+		}
+	} else {
+		streak = 0
+	}
+	s.Meters[MeterHungerStreak] = streak
+}
+
 func updateTemperatureMeters(s *Survivor) {
 	exposure := s.Meters[MeterColdExposure]
 	if isHypothermiaExposure(s.Environment) {
@@ -67,17 +82,34 @@ func updateTemperatureMeters(s *Survivor) {
 		warm = 0
 	}
 	s.Meters[MeterWarmStreak] = warm
+	heat := s.Meters[MeterHeatExposure]
+	if isHeatExposure(s.Environment) {
+		if heat < math.MaxInt32 {
+			heat++
+		}
+	} else if heat > 0 {
+		heat--
+	}
+	s.Meters[MeterHeatExposure] = heat
+	cool := s.Meters[MeterCoolStreak]
+	if isCoolShelter(s.Environment) {
+		if cool < math.MaxInt32 {
+			cool++
+		}
+	} else {
+		cool = 0
+	}
+	s.Meters[MeterCoolStreak] = cool
 }
 
 func updateFeverMeters(s *Survivor, choice Choice) {
 	rest := s.Meters[MeterFeverRest]
-	if rest > 0 {
-		rest--
-	}
-	if choice.Archetype == "rest" {
+	if archetypeCategory(choice.Archetype) == "rest" {
 		if rest < 8 {
 			rest++
 		}
+	} else if rest > 0 {
+		rest--
 	}
 	s.Meters[MeterFeverRest] = rest
 	med := s.Meters[MeterFeverMedication]
@@ -85,164 +117,174 @@ func updateFeverMeters(s *Survivor, choice Choice) {
 		med--
 	}
 	s.Meters[MeterFeverMedication] = med
+	if s.Meters[MeterAntibioticCourse] > 0 {
+		s.Meters[MeterAntibioticCourse]--
+	}
 }
 
-func applyDehydrationTriggers(s *Survivor, out *conditionOutcome) {
-	if s.Meters[MeterThirstStreak] >= 3 {
-		if addConditionIfAbsent(s, ConditionDehydration) {
-			out.Added = append(out.Added, ConditionDehydration)
+func updateRestMeters(s *Survivor, choice Choice) {
+	streak := s.Meters[MeterRestStreak]
+	if archetypeCategory(choice.Archetype) == "rest" {
+		if streak < 10 {
+			streak++
 		}
+	} else if streak > 0 {
+		streak--
 	}
+	s.Meters[MeterRestStreak] = streak
 }
 
-func applyHypothermiaTriggers(s *Survivor, out *conditionOutcome) {
-	if s.Meters[MeterColdExposure] >= 3 {
-		if addConditionIfAbsent(s, ConditionHypothermia) {
-			out.Added = append(out.Added, ConditionHypothermia)
+// applyConditionOnsets adds every condition whose onset clauses all hold.
+func applyConditionOnsets(s *Survivor, out *conditionOutcome) {
+	for _, cond := range AllConditions {
+		if !allHold(s, conditionRules[cond].Onset) {
+			continue
+		}
+		if addConditionIfAbsent(s, cond) {
+			out.Added = append(out.Added, cond)
 		}
 	}
 }
 
-func applyExhaustionTriggers(s *Survivor, out *conditionOutcome) {
-	if s.Stats.Fatigue >= 85 {
-		if addConditionIfAbsent(s, ConditionExhaustion) {
-			out.Added = append(out.Added, ConditionExhaustion)
+// applyConditionRemovals clears conditions whose removal clauses all hold and resets their meters.
+func applyConditionRemovals(s *Survivor, out *conditionOutcome) {
+	for _, cond := range AllConditions {
+		rule := conditionRules[cond]
+		if !survivorHasCondition(*s, cond) || !allHold(s, rule.Remove) {
+			continue
+		}
+		if removeConditionIfPresent(s, cond) {
+			out.Removed = append(out.Removed, cond)
+			for _, m := range rule.ResetMeters {
+				s.Meters[m] = 0
+			}
 		}
 	}
 }
 
-func applyFeverRemoval(s *Survivor, out *conditionOutcome) {
-	if !survivorHasCondition(*s, ConditionFever) {
-		return
-	}
-	if s.Meters[MeterFeverMedication] > 0 && s.Meters[MeterFeverRest] >= 6 {
-		if removeConditionIfPresent(s, ConditionFever) {
-			out.Removed = append(out.Removed, ConditionFever)
-			s.Meters[MeterFeverRest] = 0
-			s.Meters[MeterFeverMedication] = 0
+// progressConditions ages every active condition, worsens untreated ones on their
+// rule's schedule and adds linked conditions once a severity threshold is reached.
+func progressConditions(s *Survivor, turn int, out *conditionOutcome) {
+	active := append([]Condition{}, s.Conditions...)
+	for _, cond := range active {
+		st := conditionDetail(s, cond, turn)
+		rule := conditionRules[cond]
+		st.Scenes++
+		if rule.WorsenEvery > 0 && st.Scenes%rule.WorsenEvery == 0 && st.Severity < maxConditionSeverity {
+			st.Severity++
+		}
+		s.ConditionDetails[cond] = st
+		for _, esc := range rule.Escalates {
+			if st.Severity < esc.AtSeverity || !addConditionIfAbsent(s, esc.To) {
+				continue
+			}
+			conditionDetail(s, esc.To, turn)
+			out.Added = append(out.Added, esc.To)
 		}
 	}
 }
 
-func applyConditionRemovals(s *Survivor, out *conditionOutcome) {
-	if survivorHasCondition(*s, ConditionDehydration) {
-		if s.Stats.Thirst <= 40 && s.Meters[MeterHydrationRecovery] >= 2 {
-			if removeConditionIfPresent(s, ConditionDehydration) {
-				out.Removed = append(out.Removed, ConditionDehydration)
-				s.Meters[MeterHydrationRecovery] = 0
-			}
-		}
+// conditionDetail returns the progression record for an active condition, creating
+// a fresh severity-1 record when the condition was added without one.
+func conditionDetail(s *Survivor, cond Condition, turn int) ConditionState {
+	if s.ConditionDetails == nil {
+		s.ConditionDetails = make(map[Condition]ConditionState)
 	}
-	if survivorHasCondition(*s, ConditionHypothermia) {
-		if s.Meters[MeterWarmStreak] >= 4 {
-			if removeConditionIfPresent(s, ConditionHypothermia) {
-				out.Removed = append(out.Removed, ConditionHypothermia)
-				s.Meters[MeterWarmStreak] = 0
-				s.Meters[MeterColdExposure] = 0
-			}
-		}
+	st, ok := s.ConditionDetails[cond]
+	if !ok {
+		st = ConditionState{Severity: 1, OnsetTurn: turn}
+		s.ConditionDetails[cond] = st
 	}
-	if survivorHasCondition(*s, ConditionExhaustion) {
-		if s.Stats.Fatigue <= 50 {
-			if removeConditionIfPresent(s, ConditionExhaustion) {
-				out.Removed = append(out.Removed, ConditionExhaustion)
-				s.Meters[MeterExhaustionScenes] = 0
-			}
-		}
+	return st
+}
+
+// severityScaled grows a tick value with severity; severity 5 doubles it.
+func severityScaled(v, severity int) int {
+	if severity <= 1 {
+		return v
 	}
+	return v + v*(severity-1)/(maxConditionSeverity-1)
 }
 
 func conditionTick(s *Survivor, diff Difficulty) Stats {
 	total := Stats{}
 	for _, cond := range s.Conditions {
-		switch cond {
-		case ConditionBleeding:
-			total.Health -= bleedDamage(diff)
-			total.Fatigue += 2
-		case ConditionDehydration:
-			total.Fatigue += 2
-			total.Morale -= 2
-			total.Health -= dehydrationDamage(diff)
-		case ConditionFever:
-			total.Fatigue += 1
-			total.Morale -= 2
-			total.Health -= feverDamage(diff)
-		case ConditionHypothermia:
-			total.Fatigue += 2
-			total.Health -= hypothermiaDamage(diff)
-		case ConditionExhaustion:
-			s.Meters[MeterExhaustionScenes]++
-			if s.Meters[MeterExhaustionScenes] >= 4 {
-				total.Health -= exhaustionDamage(diff)
+		severity := s.ConditionDetails[cond].Severity
+		for _, tick := range conditionRules[cond].Ticks {
+			if len(tick.When) > 0 && !allHold(s, tick.When) {
+				continue
+			}
+			for m, inc := range tick.Meters {
+				s.Meters[m] += inc
+			}
+			for key, v := range tick.Stats {
+				addStatValue(&total, key, severityScaled(v.at(diff), severity))
 			}
 		}
 	}
-	if !survivorHasCondition(*s, ConditionExhaustion) {
-		s.Meters[MeterExhaustionScenes] = 0
+	for _, cond := range AllConditions {
+		if survivorHasCondition(*s, cond) {
+			continue
+		}
+		for _, m := range conditionRules[cond].IdleReset {
+			s.Meters[m] = 0
+		}
 	}
+	total.Health = traitConditionDamage(s.Traits, total.Health)
 	return total
 }
 
-func bleedDamage(diff Difficulty) int {
-	switch diff {
-	case DifficultyEasy:
-		return 4
-	case DifficultyHard:
-		return 8
-	default:
-		return 6
+func isHypothermiaExposure(env Environment) bool {
+	cold := env.TempBand == TempFreezing || env.TempBand == TempCold
+	if !cold {
+		return false
 	}
-}
-
-func dehydrationDamage(diff Difficulty) int {
-	switch diff {
-	case DifficultyEasy:
-		return 0
+	switch env.Location {
+	case LocationForest, LocationCoast, LocationMountain, LocationRural:
+		return true
 	default:
-		return 1
+		return false
 	}
 }
 
-func feverDamage(diff Difficulty) int {
-	switch diff {
-	case DifficultyEasy:
-		return 0
-	default:
-		return 1
+func isHeatExposure(env Environment) bool {
+	if env.TempBand == TempScorching || env.Weather == WeatherHeatwave {
+		return env.Location != LocationSubterranean
 	}
-}
-
-func hypothermiaDamage(diff Difficulty) int {
-	switch diff {
-	case DifficultyEasy:
-		return 2
-	case DifficultyHard:
-		return 4
+	if env.TempBand != TempHot {
+		return false
+	}
+	switch env.Location {
+	case LocationDesert, LocationCanyon, LocationPlateau, LocationCoast, LocationRural, LocationIsland:
+		return true
 	default:
-		return 3
+		return false
 	}
 }
 
-func exhaustionDamage(diff Difficulty) int {
-	switch diff {
-	case DifficultyHard:
-		return 2
-	default:
-		return 1
+func isCoolShelter(env Environment) bool {
+	if env.Weather == WeatherHeatwave {
+		return env.Location == LocationSubterranean
 	}
+	return env.TempBand == TempMild || env.TempBand == TempCold || env.TempBand == TempFreezing || env.TempBand == TempArctic || env.Location == LocationSubterranean
 }
 
-func isHypothermiaExposure(env Environment) bool {
-	cold := env.TempBand == TempFreezing || env.TempBand == TempCold
-	if !cold {
-		return false
+// heatThirstDrain is the extra thirst from sweating through hot conditions.
+func heatThirstDrain(env Environment, diff Difficulty) int {
+	if !isHeatExposure(env) {
+		return 0
 	}
-	switch env.Location {
-	case LocationForest, LocationCoast, LocationMountain, LocationRural:
-		return true
-	default:
-		return false
+	drain := 2
+	if env.TempBand == TempScorching || env.Weather == WeatherHeatwave {
+		drain = 4
+	}
+	switch diff {
+	case DifficultyEasy:
+		drain--
+	case DifficultyHard:
+		drain++
 	}
+	return drain
 }
 
 func isWarmShelter(env Environment) bool {
@@ -2,9 +2,19 @@ package engine
 
 import "testing"
 
+// testSurvivor is the first survivor of the run seeded from label, starting at Fort Detrick.
+// Each adjust runs on it in order before it is returned.
+func testSurvivor(label string, adjust ...func(*Survivor)) Survivor {
+    seed, _ := NewRunSeed(label)
+    s := NewFirstSurvivor(seed.Stream("sv"), seed, "USAMRIID/Fort Detrick (USA)")
+    for _, a := range adjust {
+        a(&s)
+    }
+    return s
+}
+
 func newTestSurvivor() *Survivor {
-    seed, _ := NewRunSeed("cond-seed")
-    s := NewFirstSurvivor(seed.Stream("s"), "USAMRIID/Fort Detrick (USA)")
+    s := testSurvivor("cond-seed")
     return &s
 }
 
@@ -13,16 +23,198 @@ func TestDehydrationTriggerAndRemoval(t *testing.T) {
     s.Stats.Thirst = 85
     // three scenes with high thirst to trigger
     for i := 0; i < 3; i++ {
-        advanceConditions(s, DifficultyStandard, Choice{}, Stats{})
+        advanceConditions(s, DifficultyStandard, Choice{}, Stats{}, 0)
     }
     if !survivorHasCondition(*s, ConditionDehydration) {
         t.Fatalf("expected dehydration after 3 thirsty scenes")
     }
     // strong rehydration over two scenes; ensure threshold is met when recovery reaches 2
-    advanceConditions(s, DifficultyStandard, Choice{}, Stats{Thirst: -12})
+    advanceConditions(s, DifficultyStandard, Choice{}, Stats{Thirst: -12}, 0)
     s.Stats.Thirst = 40 // set before second increment
-    advanceConditions(s, DifficultyStandard, Choice{}, Stats{Thirst: -12})
+    advanceConditions(s, DifficultyStandard, Choice{}, Stats{Thirst: -12}, 0)
     if survivorHasCondition(*s, ConditionDehydration) {
         t.Fatalf("expected dehydration removed after recovery")
     }
 }
+
+func TestConditionRulesCoverEveryCondition(t *testing.T) {
+    for _, cond := range AllConditions {
+        rule, ok := conditionRules[cond]
+        if !ok {
+            t.Fatalf("missing rule for %s", cond)
+        }
+        if len(rule.Ticks) == 0 {
+            t.Fatalf("condition %s has no tick effect", cond)
+        }
+    }
+}
+
+func TestLoadConditionRulesRejectsInvalid(t *testing.T) {
+    if err := LoadConditionRules([]byte("bleeding:\n  onset:\n    - {stat: courage, op: \">=\", value: 1}\n")); err == nil {
+        t.Fatalf("expected unknown stat to be rejected")
+    }
+    if err := LoadConditionRules([]byte("bleeding: {}\n")); err == nil {
+        t.Fatalf("expected incomplete rule set to be rejected")
+    }
+}
+
+func TestExhaustionDamageGatedByScenes(t *testing.T) {
+    s := newTestSurvivor()
+    s.Traits = nil
+    s.Stats.Fatigue = 90
+    var delta Stats
+    for i := 0; i < 4; i++ {
+        delta = advanceConditions(s, DifficultyHard, Choice{}, Stats{}, 0).Delta
+        if i < 3 && delta.Health != 0 {
+            t.Fatalf("exhaustion should not damage before 4 scenes, got %+v at scene %d", delta, i+1)
+        }
+    }
+    if delta.Health != -2 {
+        t.Fatalf("expected hard exhaustion damage of 2 on scene 4, got %+v", delta)
+    }
+}
+
+func TestUntreatedBleedingEscalatesToInfection(t *testing.T) {
+    s := newTestSurvivor()
+    addConditionIfAbsent(s, ConditionBleeding)
+    var added []Condition
+    for turn := 1; turn <= 4; turn++ {
+        out := advanceConditions(s, DifficultyStandard, Choice{}, Stats{}, turn)
+        added = append(added, out.Added...)
+    }
+    if got := s.ConditionDetails[ConditionBleeding]; got.Severity != 3 || got.OnsetTurn != 1 {
+        t.Fatalf("expected bleeding severity 3 from turn 1, got %+v", got)
+    }
+    if !survivorHasCondition(*s, ConditionInfection) || !containsCondition(added, ConditionInfection) {
+        t.Fatalf("expected infection to surface after untreated bleeding, added=%v", added)
+    }
+    if got := s.ConditionDetails[ConditionInfection]; got.OnsetTurn != 4 || got.Severity != 1 {
+        t.Fatalf("expected fresh infection at turn 4, got %+v", got)
+    }
+}
+
+func TestSeverityScalesConditionDamage(t *testing.T) {
+    s := newTestSurvivor()
+    s.Traits = nil
+    addConditionIfAbsent(s, ConditionSepsis)
+    s.ConditionDetails = map[Condition]ConditionState{ConditionSepsis: {Severity: 5}}
+    delta := conditionTick(s, DifficultyStandard)
+    if delta.Health != -10 {
+        t.Fatalf("expected severity 5 sepsis to double damage to -10, got %+v", delta)
+    }
+}
+
+func TestSustainedHungerCausesMalnutrition(t *testing.T) {
+    s := newTestSurvivor()
+    s.Stats.Hunger = 85
+    for turn := 0; turn < 3; turn++ {
+        advanceConditions(s, DifficultyStandard, Choice{}, Stats{}, turn)
+    }
+    if survivorHasCondition(*s, ConditionMalnutrition) {
+        t.Fatalf("malnutrition should need sustained hunger")
+    }
+    out := advanceConditions(s, DifficultyStandard, Choice{}, Stats{}, 3)
+    if !containsCondition(out.Added, ConditionMalnutrition) {
+        t.Fatalf("expected malnutrition after four hungry scenes, got %v", out.Added)
+    }
+}
+
+func TestRemovingConditionClearsDetails(t *testing.T) {
+    s := newTestSurvivor()
+    addConditionIfAbsent(s, ConditionSprain)
+    advanceConditions(s, DifficultyStandard, Choice{}, Stats{}, 1)
+    if _, ok := s.ConditionDetails[ConditionSprain]; !ok {
+        t.Fatalf("expected sprain detail to be tracked")
+    }
+    removeConditionIfPresent(s, ConditionSprain)
+    if _, ok := s.ConditionDetails[ConditionSprain]; ok {
+        t.Fatalf("expected sprain detail cleared on removal")
+    }
+}
+
+func containsCondition(list []Condition, cond Condition) bool {
+    for _, c := range list {
+        if c == cond {
+            return true
+        }
+    }
+    return false
+}
+
+func TestHeatExposureTriggersHeatstroke(t *testing.T) {
+    s := newTestSurvivor()
+    s.Conditions = nil
+    s.Stats.Thirst = 20
+    s.Environment.TempBand = TempHot
+    s.Environment.Location = LocationDesert
+    var out conditionOutcome
+    for turn := 0; turn < 3; turn++ {
+        out = advanceConditions(s, DifficultyStandard, Choice{}, Stats{}, turn)
+        if out.Delta.Thirst < 2 {
+            t.Fatalf("expected heat to add thirst drain, got %+v", out.Delta)
+        }
+    }
+    if !containsCondition(out.Added, ConditionHeatstroke) {
+        t.Fatalf("expected heatstroke after three hot desert scenes, meters=%v", s.Meters)
+    }
+    s.Environment.TempBand = TempMild
+    for turn := 3; turn < 6; turn++ {
+        advanceConditions(s, DifficultyStandard, Choice{Archetype: "rest"}, Stats{}, turn)
+    }
+    if survivorHasCondition(*s, ConditionHeatstroke) {
+        t.Fatalf("expected heatstroke cleared after cooling down, meters=%v", s.Meters)
+    }
+}
+
+func TestHeatwaveIgnoresShelterUnderground(t *testing.T) {
+    env := Environment{Weather: WeatherHeatwave, TempBand: TempHot, Location: LocationCity}
+    if !isHeatExposure(env) {
+        t.Fatalf("expected heatwave to expose city survivors")
+    }
+    env.Location = LocationSubterranean
+    if isHeatExposure(env) || !isCoolShelter(env) {
+        t.Fatalf("expected underground to shelter from a heatwave")
+    }
+}
+
+func TestSepsisClearsWithAntibioticsAndRest(t *testing.T) {
+    s := newTestSurvivor()
+    addConditionIfAbsent(s, ConditionSepsis)
+    st := conditionDetail(s, ConditionSepsis, 0)
+    st.Severity = maxConditionSeverity
+    s.ConditionDetails[ConditionSepsis] = st
+    rest := Choice{Archetype: "rest"}
+    for i := 0; i < 4; i++ {
+        advanceConditions(s, DifficultyStandard, rest, Stats{}, i)
+    }
+    if !survivorHasCondition(*s, ConditionSepsis) {
+        t.Fatalf("expected rest alone not to clear sepsis")
+    }
+    s.Skills[SkillPharmacology] = 1
+    s.Inventory.Medical = append(s.Inventory.Medical, "antibiotics")
+    if res := applyTreatment(s, "antibiotics"); res.Item != "antibiotics" || len(res.Removed) != 0 {
+        t.Fatalf("expected antibiotics to ease but not clear severe sepsis, got %+v", res)
+    }
+    advanceConditions(s, DifficultyStandard, rest, Stats{}, 5)
+    if survivorHasCondition(*s, ConditionSepsis) {
+        t.Fatalf("expected antibiotics plus a rest streak to clear sepsis")
+    }
+    if s.Meters[MeterAntibioticCourse] != 0 {
+        t.Fatalf("expected the antibiotic course reset once sepsis cleared, got %d", s.Meters[MeterAntibioticCourse])
+    }
+}
+
+func TestNerveDamageClearsAfterLongRest(t *testing.T) {
+    s := newTestSurvivor()
+    addConditionIfAbsent(s, ConditionNerveDamage)
+    for i := 0; i < 9; i++ {
+        advanceConditions(s, DifficultyStandard, Choice{Archetype: "rest"}, Stats{}, i)
+    }
+    if !survivorHasCondition(*s, ConditionNerveDamage) {
+        t.Fatalf("expected nerve damage to persist through a short rest")
+    }
+    advanceConditions(s, DifficultyStandard, Choice{Archetype: "rest"}, Stats{}, 9)
+    if survivorHasCondition(*s, ConditionNerveDamage) {
+        t.Fatalf("expected ten scenes of rest to clear nerve damage")
+    }
+}
@@ -0,0 +1,399 @@
+package engine
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed content/*.yml
+var builtinPacks embed.FS
+
+// ContentPack is a versioned bundle of event blueprints loaded from YAML or JSON.
+type ContentPack struct {
+	ID        string           `yaml:"id"`
+	Name      string           `yaml:"name"`
+	Version   int              `yaml:"version"`
+	Events    []EventBlueprint `yaml:"events"`
+	Templates []eventTemplate  `yaml:"templates"`
+}
+
+// Ref identifies the pack and version for run records, e.g. "core@1".
+func (p ContentPack) Ref() string { return fmt.Sprintf("%s@%d", p.ID, p.Version) }
+
+// eventTemplate expands every scenario across every location into blueprints.
+type eventTemplate struct {
+	Tier           string             `yaml:"tier"`
+	Scale          string             `yaml:"scale"`
+	Weight         int                `yaml:"weight"`
+	CooldownScenes int                `yaml:"cooldown_scenes"`
+	OncePerRun     bool               `yaml:"once_per_run"`
+	Scenarios      []templateScenario `yaml:"scenarios"`
+	Locations      []string           `yaml:"locations"`
+}
+
+// templateScenario is a scenario name with optional requirements; a YAML scalar is just the name.
+type templateScenario struct {
+	Name      string                    `yaml:"name"`
+	Requires  EventRequirements         `yaml:"requires"`
+	Choices   map[string]ChoiceOverride `yaml:"choices"`
+	Encounter string                    `yaml:"encounter"`
+}
+
+func (sc *templateScenario) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		return node.Decode(&sc.Name)
+	}
+	// node.Decode does not carry the pack decoder's KnownFields, so re-decode strictly
+	raw, err := yaml.Marshal(inlineAliases(node))
+	if err != nil {
+		return err
+	}
+	type plain templateScenario
+	return strictDecode(raw, (*plain)(sc))
+}
+
+// inlineAliases copies a node with every alias replaced by the value it points at, so the
+// node can be re-encoded without the anchors defined elsewhere in the pack.
+func inlineAliases(n *yaml.Node) *yaml.Node {
+	if n.Kind == yaml.AliasNode && n.Alias != nil {
+		return inlineAliases(n.Alias)
+	}
+	out := *n
+	out.Anchor = ""
+	out.Content = make([]*yaml.Node, len(n.Content))
+	for i, c := range n.Content {
+		out.Content[i] = inlineAliases(c)
+	}
+	return &out
+}
+
+// strictDecode unmarshals YAML, rejecting keys the target has no field for so typos in
+// content packs fail loudly instead of being ignored. An empty document decodes to nothing.
+func strictDecode(data []byte, out any) error {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(out); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
+
+// DefaultContentPacks lists the built-in packs enabled for new runs.
+var DefaultContentPacks = []string{"core", "expanded"}
+
+var (
+	contentPacks = mustLoadBuiltinPacks()
+	activePacks  []string
+	eventCatalog = mustActivate(DefaultContentPacks)
+)
+
+func mustLoadBuiltinPacks() map[string]ContentPack {
+	packs := map[string]ContentPack{}
+	entries, err := builtinPacks.ReadDir("content")
+	if err != nil {
+		panic(fmt.Sprintf("engine: read built-in content packs: %v", err))
+	}
+	for _, e := range entries {
+		data, err := builtinPacks.ReadFile("content/" + e.Name())
+		if err != nil {
+			panic(fmt.Sprintf("engine: read content pack %s: %v", e.Name(), err))
+		}
+		p, err := ParseContentPack(data)
+		if err != nil {
+			panic(fmt.Sprintf("engine: invalid built-in content pack %s: %v", e.Name(), err))
+		}
+		packs[p.ID] = p
+	}
+	return packs
+}
+
+func mustActivate(ids []string) []EventBlueprint {
+	catalog, refs, err := buildCatalog(ids)
+	if err != nil {
+		panic(fmt.Sprintf("engine: invalid default content packs: %v", err))
+	}
+	activePacks = refs
+	return catalog
+}
+
+// ParseContentPack decodes and validates a single pack. JSON is accepted as a YAML subset.
+// Unknown keys are rejected.
+func ParseContentPack(data []byte) (ContentPack, error) {
+	var p ContentPack
+	if err := strictDecode(data, &p); err != nil {
+		return ContentPack{}, err
+	}
+	p.ID = strings.TrimSpace(p.ID)
+	if p.ID == "" || strings.ContainsAny(p.ID, "@ ") {
+		return ContentPack{}, fmt.Errorf("pack id %q must be non-empty without spaces or '@'", p.ID)
+	}
+	if p.Version < 1 {
+		return ContentPack{}, fmt.Errorf("pack %s: version must be >= 1", p.ID)
+	}
+	if err := validateCatalog(p.blueprints()); err != nil {
+		return ContentPack{}, fmt.Errorf("pack %s: %w", p.ID, err)
+	}
+	return p, nil
+}
+
+// blueprints returns the pack's explicit events followed by its expanded templates.
+func (p ContentPack) blueprints() []EventBlueprint {
+	out := append([]EventBlueprint{}, p.Events...)
+	for _, tmpl := range p.Templates {
+		for _, sc := range tmpl.Scenarios {
+			for _, location := range tmpl.Locations {
+				out = append(out, EventBlueprint{
+					ID:             slugify(strings.Join([]string{sc.Name, location, tmpl.Tier}, " ")),
+					Name:           fmt.Sprintf("%s at %s", sc.Name, location),
+					Tier:           tmpl.Tier,
+					Scale:          tmpl.Scale,
+					Weight:         tmpl.Weight,
+					CooldownScenes: tmpl.CooldownScenes,
+					OncePerRun:     tmpl.OncePerRun,
+					Requires:       sc.Requires,
+					Choices:        sc.Choices,
+					Encounter:      sc.Encounter,
+				})
+			}
+		}
+	}
+	return out
+}
+
+// RegisterContentPack makes a pack available for activation, replacing any pack with the same id.
+func RegisterContentPack(data []byte) (ContentPack, error) {
+	p, err := ParseContentPack(data)
+	if err != nil {
+		return ContentPack{}, err
+	}
+	contentPacks[p.ID] = p
+	return p, nil
+}
+
+// LoadContentPackDir registers every .yml, .yaml and .json pack in dir.
+func LoadContentPackDir(dir string) ([]ContentPack, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var loaded []ContentPack
+	for _, e := range entries {
+		switch strings.ToLower(filepath.Ext(e.Name())) {
+		case ".yml", ".yaml", ".json":
+		default:
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return loaded, err
+		}
+		p, err := RegisterContentPack(data)
+		if err != nil {
+			return loaded, fmt.Errorf("%s: %w", e.Name(), err)
+		}
+		loaded = append(loaded, p)
+	}
+	return loaded, nil
+}
+
+// AvailableContentPacks returns every registered pack ordered by id.
+func AvailableContentPacks() []ContentPack {
+	out := make([]ContentPack, 0, len(contentPacks))
+	for _, p := range contentPacks {
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+// ActivateContentPacks rebuilds the event catalog from the given packs, in order. Entries may
+// be a bare id ("core") or a pinned ref ("core@1"); a pinned version must match the registered
+// pack. The combined catalog is validated before it replaces the active one.
+func ActivateContentPacks(ids []string) error {
+	catalog, refs, err := buildCatalog(ids)
+	if err != nil {
+		return err
+	}
+	eventCatalog = catalog
+	activePacks = refs
+	return nil
+}
+
+// ActiveContentPacks returns the refs of the packs behind the current catalog, for recording
+// next to RulesVersion.
+func ActiveContentPacks() []string { return append([]string{}, activePacks...) }
+
+func buildCatalog(ids []string) ([]EventBlueprint, []string, error) {
+	if len(ids) == 0 {
+		return nil, nil, fmt.Errorf("at least one content pack must be enabled")
+	}
+	var (
+		catalog []EventBlueprint
+		refs    []string
+		seen    = map[string]bool{}
+	)
+	for _, raw := range ids {
+		id, version, pinned := strings.Cut(strings.TrimSpace(raw), "@")
+		p, ok := contentPacks[id]
+		if !ok {
+			return nil, nil, fmt.Errorf("unknown content pack %q", id)
+		}
+		if pinned && version != fmt.Sprint(p.Version) {
+			return nil, nil, fmt.Errorf("content pack %s is version %d, run expects %s", id, p.Version, version)
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		catalog = append(catalog, p.blueprints()...)
+		refs = append(refs, p.Ref())
+	}
+	if err := validateCatalog(catalog); err != nil {
+		return nil, nil, err
+	}
+	return catalog, refs, nil
+}
+
+// validateCatalog checks unique ids, tier/scale vocabularies, weights, requirement enums,
+// choice overrides and that every arc starts at step 1 with no gaps.
+func validateCatalog(events []EventBlueprint) error {
+	seen := make(map[string]bool, len(events))
+	arcs := map[string]map[int]bool{}
+	for _, ev := range events {
+		if ev.ID == "" || ev.Name == "" {
+			return fmt.Errorf("event %q: id and name are required", ev.ID)
+		}
+		if seen[ev.ID] {
+			return fmt.Errorf("duplicate event id %q", ev.ID)
+		}
+		seen[ev.ID] = true
+		switch ev.Tier {
+		case "pre_arrival", "post_arrival", "any":
+		default:
+			return fmt.Errorf("event %s: unknown tier %q", ev.ID, ev.Tier)
+		}
+		switch ev.Scale {
+		case "minor", "major":
+		default:
+			return fmt.Errorf("event %s: unknown scale %q", ev.ID, ev.Scale)
+		}
+		if ev.Weight <= 0 || ev.CooldownScenes < 0 {
+			return fmt.Errorf("event %s: weight must be positive and cooldown non-negative", ev.ID)
+		}
+		switch ev.Encounter {
+		case "", "infected", "hostile":
+		default:
+			return fmt.Errorf("event %s: unknown encounter %q", ev.ID, ev.Encounter)
+		}
+		if err := ev.Requires.validate(); err != nil {
+			return fmt.Errorf("event %s: %w", ev.ID, err)
+		}
+		for archetype, o := range ev.Choices {
+			if _, ok := archetypeProfiles[archetype]; !ok {
+				return fmt.Errorf("event %s: override for unknown archetype %q", ev.ID, archetype)
+			}
+			if err := o.validate(); err != nil {
+				return fmt.Errorf("event %s: %s override: %w", ev.ID, archetype, err)
+			}
+		}
+		if (ev.ArcID == "") != (ev.ArcStep == 0) {
+			return fmt.Errorf("event %s: arc_id and arc_step must be set together", ev.ID)
+		}
+		if ev.ArcID != "" {
+			if ev.ArcStep < 1 {
+				return fmt.Errorf("event %s: arc_step must be >= 1", ev.ID)
+			}
+			if arcs[ev.ArcID] == nil {
+				arcs[ev.ArcID] = map[int]bool{}
+			}
+			arcs[ev.ArcID][ev.ArcStep] = true
+		}
+	}
+	for arc, steps := range arcs {
+		for step := range steps {
+			if step > 1 && !steps[step-1] {
+				return fmt.Errorf("arc %s: step %d has no step %d before it", arc, step, step-1)
+			}
+		}
+	}
+	return nil
+}
+
+func (r EventRequirements) validate() error {
+	for _, l := range r.Locations {
+		if !l.Validate() {
+			return fmt.Errorf("unknown location %q", l)
+		}
+	}
+	for _, s := range r.Seasons {
+		if !s.Validate() {
+			return fmt.Errorf("unknown season %q", s)
+		}
+	}
+	for _, w := range r.Weather {
+		if !w.Validate() {
+			return fmt.Errorf("unknown weather %q", w)
+		}
+	}
+	for sk, lvl := range r.MinSkills {
+		if !sk.Validate() || lvl < 0 || lvl > maxSkillLevel {
+			return fmt.Errorf("invalid skill requirement %s=%d", sk, lvl)
+		}
+	}
+	for _, g := range r.Groups {
+		if !g.Validate() {
+			return fmt.Errorf("unknown group %q", g)
+		}
+	}
+	if r.MaxDaysSinceLAD > 0 && r.MaxDaysSinceLAD < r.MinDaysSinceLAD {
+		return fmt.Errorf("max_days_since_lad below min_days_since_lad")
+	}
+	return nil
+}
+
+func slugify(raw string) string {
+	raw = strings.ToLower(raw)
+	var b strings.Builder
+	underscore := false
+	for _, r := range raw {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if underscore {
+				b.WriteRune('_')
+				underscore = false
+			}
+			b.WriteRune(r)
+		case r == ' ' || r == '-' || r == ':' || r == '/' || r == '\\':
+			underscore = true
+		case r == '&':
+			if underscore {
+				b.WriteRune('_')
+				underscore = false
+			}
+			b.WriteString("and")
+		case r == '+':
+			if underscore {
+				b.WriteRune('_')
+				underscore = false
+			}
+			b.WriteString("plus")
+		default:
+			underscore = true
+		}
+	}
+	slug := strings.Trim(b.String(), "_")
+	if slug == "" {
+		return "event"
+	}
+	return slug
+}
@@ -0,0 +1,74 @@
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBuiltinPacksActiveByDefault(t *testing.T) {
+	refs := ActiveContentPacks()
+	if strings.Join(refs, ",") != "core@1,expanded@1" {
+		t.Fatalf("unexpected default packs: %v", refs)
+	}
+	if _, ok := catalogByID()["hospital_overrun"]; !ok {
+		t.Fatalf("expected core events in the catalog")
+	}
+	seed, _ := NewRunSeed("packs")
+	if w := NewWorld(seed, "1.0.0"); len(w.ContentPacks) != 2 {
+		t.Fatalf("expected world to record its content packs, got %v", w.ContentPacks)
+	}
+}
+
+func TestParseContentPackValidation(t *testing.T) {
+	cases := map[string]string{
+		"duplicate id":         "id: p\nversion: 1\nevents:\n  - {id: a, name: A, tier: any, scale: minor, weight: 1}\n  - {id: a, name: B, tier: any, scale: minor, weight: 1}\n",
+		"bad tier":             "id: p\nversion: 1\nevents:\n  - {id: a, name: A, tier: someday, scale: minor, weight: 1}\n",
+		"bad scale":            "id: p\nversion: 1\nevents:\n  - {id: a, name: A, tier: any, scale: epic, weight: 1}\n",
+		"arc gap":              "id: p\nversion: 1\nevents:\n  - {id: a, name: A, tier: any, scale: minor, weight: 1, arc_id: x, arc_step: 1}\n  - {id: c, name: C, tier: any, scale: minor, weight: 1, arc_id: x, arc_step: 3}\n",
+		"bad location":         "id: p\nversion: 1\nevents:\n  - {id: a, name: A, tier: any, scale: minor, weight: 1, requires: {locations: [moon]}}\n",
+		"no version":           "id: p\nevents:\n  - {id: a, name: A, tier: any, scale: minor, weight: 1}\n",
+		"unknown key":          "id: p\nversion: 1\nevents:\n  - {id: a, name: A, tier: any, scale: minor, weight: 1, cooldown: 3}\n",
+		"unknown scenario key": "id: p\nversion: 1\ntemplates:\n  - {tier: any, scale: minor, weight: 1, locations: [city], scenarios: [{name: S, encounters: hostile}]}\n",
+	}
+	for name, doc := range cases {
+		if _, err := ParseContentPack([]byte(doc)); err == nil {
+			t.Fatalf("%s: expected pack to be rejected", name)
+		}
+	}
+}
+
+func TestActivateContentPacks(t *testing.T) {
+	t.Cleanup(func() { _ = ActivateContentPacks(DefaultContentPacks) })
+	dir := t.TempDir()
+	pack := `{"id": "coastal", "version": 2, "events": [{"id": "tide_cache", "name": "Tide Cache", "tier": "any", "scale": "minor", "weight": 3, "cooldown_scenes": 1, "requires": {"locations": ["coast"]}}]}`
+	if err := os.WriteFile(filepath.Join(dir, "coastal.json"), []byte(pack), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadContentPackDir(dir); err != nil {
+		t.Fatalf("load pack dir: %v", err)
+	}
+	if err := ActivateContentPacks([]string{"core@1", "coastal"}); err != nil {
+		t.Fatalf("activate: %v", err)
+	}
+	catalog := catalogByID()
+	if _, ok := catalog["tide_cache"]; !ok {
+		t.Fatalf("expected pack event in catalog")
+	}
+	if _, ok := catalog[slugify("Grocery Dash Midtown Pharmacy pre_arrival")]; ok {
+		t.Fatalf("expanded pack should be disabled")
+	}
+	if got := strings.Join(ActiveContentPacks(), ","); got != "core@1,coastal@2" {
+		t.Fatalf("unexpected active refs %s", got)
+	}
+	if err := ActivateContentPacks([]string{"coastal@1"}); err == nil {
+		t.Fatalf("expected version mismatch to be rejected")
+	}
+	if err := ActivateContentPacks([]string{"missing"}); err == nil {
+		t.Fatalf("expected unknown pack to be rejected")
+	}
+	if got := strings.Join(ActiveContentPacks(), ","); got != "core@1,coastal@2" {
+		t.Fatalf("failed activation should keep the previous catalog, got %s", got)
+	}
+}
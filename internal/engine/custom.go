@@ -1,40 +1,96 @@
 package engine
 
 import (
+	"fmt"
 	"strings"
 )
 
-// Custom action validation maps free text to an archetypal Choice or rejects it.
-// Returns (choice, allowed, rejectionReason).
-// ValidateCustomAction extended with gating: rejects if fatigue>85 (except rest), hunger>95 or thirst>95 (except forage), or repeating same archetype consecutively.
-func ValidateCustomAction(input string, base Survivor) (Choice, bool, string) {
+// customActionRules limit how often free-text actions can be taken on a difficulty.
+type customActionRules struct {
+	Cooldown   int // turns that must pass between custom actions
+	MaxRepeats int // consecutive custom actions allowed with the same archetype
+}
+
+var customRules = map[Difficulty]customActionRules{
+	DifficultyEasy:     {Cooldown: 1, MaxRepeats: 3},
+	DifficultyStandard: {Cooldown: 2, MaxRepeats: 2},
+	DifficultyHard:     {Cooldown: 3, MaxRepeats: 1},
+}
+
+func customRulesFor(diff Difficulty) customActionRules {
+	if r, ok := customRules[diff]; ok {
+		return r
+	}
+	return customRules[DifficultyStandard]
+}
+
+// ValidateCustomAction maps free text to an archetypal Choice or rejects it, returning
+// (choice, allowed, rejectionReason). The text is parsed with ParseIntent; negated or
+// ambiguous actions are refused.
+// Custom actions recharge over the difficulty's cooldown; currentTurn is the turn the action
+// would resolve on. Above 85 fatigue only rest and medicate are allowed (tireless survivors
+// push further). Above 95 hunger or thirst only forage and medicate are allowed. The same
+// archetype can't run more than the difficulty's limit of times in a row, except rest and
+// medicate.
+// Medicate needs a carried medical item the survivor has the skill to use. Under
+// WithScarcity, supply runs (forage, hunt, trade) carry one more tier of risk. Travel that
+// names a reachable place ("drive to the harbor", "sail to North Africa") follows that leg;
+// pass WithWorld so region links are known.
+func ValidateCustomAction(input string, base Survivor, currentTurn int, diff Difficulty, opts ...ChoiceOption) (Choice, bool, string) {
+	cfg := choiceConfig{difficulty: diff}
+	for _, o := range opts {
+		o(&cfg)
+	}
 	in := strings.ToLower(strings.TrimSpace(input))
 	if in == "" {
 		return Choice{}, false, "empty"
 	}
-	archetype := ""
+	rules := customRulesFor(diff)
+	if last, ok := base.Meters[MeterCustomLastTurn]; ok {
+		if wait := last + rules.Cooldown - currentTurn; wait > 0 {
+			return Choice{}, false, fmt.Sprintf("Custom actions recharge in %d more turn(s)", wait)
+		}
+	}
+	intent := ParseIntent(in, base.Inventory)
 	switch {
-	case hasAny(in, "rest", "sleep", "recover", "nap"):
-		archetype = "rest"
-	case hasAny(in, "forage", "search", "scavenge", "look for", "gather"):
-		archetype = "forage"
-	case hasAny(in, "scout", "peek", "survey", "recon"):
-		archetype = "scout"
-	case hasAny(in, "organize", "sort", "arrange"):
-		archetype = "organize"
-	case hasAny(in, "barricade", "board", "secure"):
-		archetype = "barricade"
-	default:
+	case intent.Archetype == "":
 		return Choice{}, false, "No supported action archetype found"
+	case intent.Negated:
+		return Choice{}, false, "Say what to do rather than what not to do"
+	case intent.Confidence < minIntentConfidence:
+		return Choice{}, false, "Ambiguous action; name one thing to do"
 	}
+	archetype := intent.Archetype
 	// Gating rules
-	if archetype != "rest" && base.Stats.Fatigue > 85 {
+	if archetype == base.LastCustom && archetype != "rest" && archetype != "medicate" && base.Meters[MeterCustomStreak] >= rules.MaxRepeats {
+		return Choice{}, false, fmt.Sprintf("Already tried %s %d time(s) in a row; try something else", archetype, base.Meters[MeterCustomStreak])
+	}
+	if t, blocked := traitBlocks(base.Traits, archetype); blocked {
+		return Choice{}, false, "Not something a " + string(t) + " survivor would do"
+	}
+	if archetype != "rest" && archetype != "medicate" && base.Stats.Fatigue > 85+traitFatigueGate(base.Traits) {
 		return Choice{}, false, "Too fatigued"
 	}
-	if archetype != "forage" && (base.Stats.Hunger > 95 || base.Stats.Thirst > 95) {
+	if archetype != "forage" && archetype != "medicate" && (base.Stats.Hunger > 95 || base.Stats.Thirst > 95) {
 		return Choice{}, false, "Critical needs first"
 	}
-    // cooldown enforced in UI using MeterCustomLastTurn
+	item := ""
+	if archetype == "medicate" {
+		t, ok := findTreatment(mentionedMedicalItem(intent.Items, in))
+		if !ok {
+			t, ok = bestTreatment(base)
+		}
+		if !ok || !contains(base.Inventory.Medical, t.Item) {
+			return Choice{}, false, "No usable medical supplies"
+		}
+		if base.Skills[t.Skill] < t.MinSkill {
+			return Choice{}, false, "Lacks the " + string(t.Skill) + " skill for " + t.Item
+		}
+		item = t.Item
+	}
+	if archetype == "trade" && len(carriedItems(base.Inventory)) == 0 && base.Inventory.FoodDays < 1 {
+		return Choice{}, false, "Nothing to trade"
+	}
 	// Map archetype to synthetic choice (index -1 indicates synthetic)
 	c := Choice{
 		Index:       -1,
@@ -45,6 +101,7 @@ func ValidateCustomAction(input string, base Survivor) (Choice, bool, string) {
 		Outcome:     make(ChoiceOutcome),
 		SourceEvent: "",
 		Custom:      true,
+		Item:        item,
 	}
 	switch archetype {
 	case "rest":
@@ -65,22 +122,110 @@ func ValidateCustomAction(input string, base Survivor) (Choice, bool, string) {
 	case "organize":
 		c.Cost = Cost{Time: 1}
 		c.Outcome[StatMorale] = DeltaRange{Min: 1, Max: 3}
+	case "medicate":
+		c.Cost = Cost{Time: 1}
+		c.Outcome[StatFatigue] = DeltaRange{Min: -2, Max: -2}
+	case "diplomacy":
+		c.Cost = Cost{Time: 1}
+		c.Risk = ternary(base.Environment.Infected, RiskModerate, RiskLow)
+		c.Outcome[StatMorale] = DeltaRange{Min: 1, Max: 3}
 	case "barricade":
 		c.Cost = Cost{Time: 1, Fatigue: 7}
 		c.Risk = ternary(base.Environment.Infected, RiskModerate, RiskLow)
 		c.Outcome[StatFatigue] = DeltaRange{Min: 7, Max: 9}
 		c.Outcome[StatMorale] = DeltaRange{Min: 0, Max: 1}
+	default:
+		profile := archetypeProfiles[archetype]
+		c.Cost = profile.BaseCost
+		c.Outcome = cloneOutcome(profile.BaseOutcome)
+		switch {
+		case archetype == "fight":
+			c.Risk = ternary(base.Environment.Infected, RiskHigh, RiskModerate)
+		case profile.Category == "physical":
+			c.Risk = ternary(base.Environment.Infected, RiskModerate, RiskLow)
+		}
+	}
+	c.Effects = archetypeProfiles[archetype].BaseEffects
+	if archetype == "travel" {
+		// a named destination turns the action into a real leg; "drive" and "sail" pick the mode
+		options := TravelOptions(base, cfg.world.regionGraph())
+		leg, ok := routeNamed(options, in, travelModeFor(in))
+		if !ok {
+			return Choice{}, false, travelWhere(options)
+		}
+		tc, err := TravelChoice(base, leg)
+		if err != nil {
+			return Choice{}, false, "Can't travel " + leg.Describe() + ": " + err.Error()
+		}
+		c.Route, c.Cost = tc.Route, tc.Cost
+	}
+	if archetype == "trade" && len(intent.Items) > 0 {
+		// offering a named item means giving it up
+		c.Effects = mergeEffects(c.Effects, ChoiceEffect{LoseItems: intent.Items[:1]})
 	}
+	shift := traitRiskShift(base.Traits, archetype) + infectionRiskShift(base, archetype)
+	if isSupplyArchetype(archetype) {
+		shift += economyFor(cfg.scarcity).SupplyRisk
+	}
+	c.Risk = shiftRisk(c.Risk, shift)
 	return c, true, ""
 }
 
-func hasAny(s string, subs ...string) bool {
-	for _, sub := range subs {
-		if strings.Contains(s, sub) {
-			return true
+// travelWhere asks for a destination, naming the places the survivor could head for.
+func travelWhere(options []TravelRoute) string {
+	var dests []string
+	for _, r := range options {
+		dest := strings.ReplaceAll(string(r.ToLocation), "_", " ")
+		if r.CrossesRegions() {
+			dest = r.ToRegion
+		}
+		if !contains(dests, dest) {
+			dests = append(dests, dest)
+		}
+	}
+	if len(dests) == 0 {
+		return "Nowhere to travel from here"
+	}
+	return "Travel where? Try " + strings.Join(dests, ", ")
+}
+
+// recordCustomAction stamps the turn of a resolved custom action and tracks how many
+// custom actions in a row shared its archetype.
+func recordCustomAction(s *Survivor, archetype string, turn int) {
+	if s.Meters == nil {
+		s.Meters = make(map[Meter]int)
+	}
+	if archetype == s.LastCustom {
+		s.Meters[MeterCustomStreak]++
+	} else {
+		s.Meters[MeterCustomStreak] = 1
+	}
+	s.LastCustom = archetype
+	s.Meters[MeterCustomLastTurn] = turn
+}
+
+// customMatchOrder breaks ties between equally scored intents, so specific actions
+// ("treat the wound", "fight") win over broad ones ("rest", "search").
+var customMatchOrder = []string{
+	"medicate", "fight", "hunt", "rest", "forage", "hide", "scout", "travel", "trade",
+	"signal", "repair", "organize", "barricade", "diplomacy", "craft", "observe",
+}
+
+// mentionedMedicalItem returns the treatment item named in the input, preferring carried
+// items the parser found.
+func mentionedMedicalItem(items []string, in string) string {
+	for _, item := range items {
+		if _, ok := findTreatment(item); ok {
+			return item
+		}
+	}
+	for _, t := range treatmentTable {
+		// singular mentions count too ("antibiotic", "painkiller")
+		if strings.Contains(in, strings.TrimSuffix(t.Item, "s")) {
+			return t.Item
 		}
 	}
-	return false
+	return ""
 }
 
 func ternary[T any](cond bool, a, b T) T {
@@ -1,29 +1,69 @@
 package engine
 
-import "testing"
+import (
+    "strings"
+    "testing"
+)
 
 func TestCustomGating_FatigueCritical(t *testing.T) {
     s := Survivor{Stats: Stats{Fatigue: 90}}
-    if _, ok, _ := ValidateCustomAction("forage the area", s); ok {
+    if _, ok, _ := ValidateCustomAction("forage the area", s, 0, DifficultyStandard); ok {
         t.Fatalf("expected custom action denied when fatigue>85 and not rest")
     }
-    if _, ok, _ := ValidateCustomAction("rest now", s); !ok {
+    if _, ok, _ := ValidateCustomAction("rest now", s, 0, DifficultyStandard); !ok {
         t.Fatalf("expected rest allowed even when fatigue high")
     }
 }
 
 func TestCustomGating_NeedsCritical(t *testing.T) {
     s := Survivor{Stats: Stats{Hunger: 96, Thirst: 50}}
-    if _, ok, _ := ValidateCustomAction("organize supplies", s); ok {
+    if _, ok, _ := ValidateCustomAction("organize supplies", s, 0, DifficultyStandard); ok {
         t.Fatalf("expected non-forage denied when hunger critical")
     }
     s = Survivor{Stats: Stats{Hunger: 50, Thirst: 96}}
-    if _, ok, _ := ValidateCustomAction("barricade the door", s); ok {
+    if _, ok, _ := ValidateCustomAction("barricade the door", s, 0, DifficultyStandard); ok {
         t.Fatalf("expected non-forage denied when thirst critical")
     }
     s = Survivor{Stats: Stats{Hunger: 96, Thirst: 96}}
-    if _, ok, _ := ValidateCustomAction("forage the area", s); !ok {
+    if _, ok, _ := ValidateCustomAction("forage the area", s, 0, DifficultyStandard); !ok {
         t.Fatalf("expected forage allowed at critical needs")
     }
 }
 
+
+func TestCustomCooldownEnforcedByEngine(t *testing.T) {
+    s := Survivor{Stats: Stats{Health: 100}, Meters: baselineMeters()}
+    c, ok, _ := ValidateCustomAction("scout the ridge", s, 4, DifficultyStandard)
+    if !ok {
+        t.Fatalf("expected first custom action allowed")
+    }
+    ApplyChoice(&s, c, DifficultyStandard, 4, nil)
+    if _, ok, reason := ValidateCustomAction("forage the area", s, 5, DifficultyStandard); ok || !strings.Contains(reason, "recharge") {
+        t.Fatalf("expected cooldown rejection, got ok=%v %q", ok, reason)
+    }
+    if _, ok, _ := ValidateCustomAction("forage the area", s, 6, DifficultyStandard); !ok {
+        t.Fatalf("expected custom action allowed once the cooldown passed")
+    }
+    if _, ok, _ := ValidateCustomAction("forage the area", s, 5, DifficultyEasy); !ok {
+        t.Fatalf("expected easy difficulty to use a shorter cooldown")
+    }
+}
+
+func TestCustomRepetitionLimitedPerDifficulty(t *testing.T) {
+    s := Survivor{Stats: Stats{Health: 100}, Meters: baselineMeters()}
+    turn := 0
+    for i := 0; i < customRulesFor(DifficultyHard).MaxRepeats; i++ {
+        c, ok, reason := ValidateCustomAction("scout the ridge", s, turn, DifficultyHard)
+        if !ok {
+            t.Fatalf("repeat %d rejected early: %s", i, reason)
+        }
+        ApplyChoice(&s, c, DifficultyHard, turn, nil)
+        turn += customRulesFor(DifficultyHard).Cooldown
+    }
+    if _, ok, reason := ValidateCustomAction("survey the ridge again", s, turn, DifficultyHard); ok || !strings.Contains(reason, "in a row") {
+        t.Fatalf("expected repetition rejection, got ok=%v %q", ok, reason)
+    }
+    if _, ok, _ := ValidateCustomAction("rest for a while", s, turn, DifficultyHard); !ok {
+        t.Fatalf("expected a different archetype allowed")
+    }
+}
@@ -116,20 +116,26 @@ var AllConditions = []Condition{ConditionBleeding, ConditionBurns, ConditionConc
 type Meter string
 
 const (
+	MeterAntibioticCourse       Meter = "antibiotic_course"
 	MeterCampVisibility         Meter = "camp_visibility"
 	MeterColdExposure           Meter = "cold_exposure"
 	MeterCommunitySentiment     Meter = "community_sentiment"
+	MeterCoolStreak             Meter = "cool_streak"
 	MeterCustomLastTurn         Meter = "custom_last_turn"
+	MeterCustomStreak           Meter = "custom_streak"
 	MeterExhaustionScenes       Meter = "exhaustion_scenes"
 	MeterFeverMedication        Meter = "fever_medication"
 	MeterFeverRest              Meter = "fever_rest"
 	MeterFortificationIntegrity Meter = "fortification_integrity"
+	MeterHeatExposure           Meter = "heat_exposure"
+	MeterHungerStreak           Meter = "hunger_streak"
 	MeterHydrationRecovery      Meter = "hydration_recovery"
 	MeterInfectionPressure      Meter = "infection_pressure"
 	MeterLeadershipTrust        Meter = "leadership_trust"
 	MeterNoise                  Meter = "noise"
 	MeterPanicLevel             Meter = "panic_level"
 	MeterRadiationExposure      Meter = "radiation_exposure"
+	MeterRestStreak             Meter = "rest_streak"
 	MeterScent                  Meter = "scent"
 	MeterSignalStrength         Meter = "signal_strength"
 	MeterStealthProfile         Meter = "stealth_profile"
@@ -141,7 +147,7 @@ const (
 	MeterWarmStreak             Meter = "warm_streak"
 )
 
-var AllMeters = []Meter{MeterCampVisibility, MeterColdExposure, MeterCommunitySentiment, MeterCustomLastTurn, MeterExhaustionScenes, MeterFeverMedication, MeterFeverRest, MeterFortificationIntegrity, MeterHydrationRecovery, MeterInfectionPressure, MeterLeadershipTrust, MeterNoise, MeterPanicLevel, MeterRadiationExposure, MeterScent, MeterSignalStrength, MeterStealthProfile, MeterSupplyBuffer, MeterSupplyOutlook, MeterThirstStreak, MeterTrust, MeterVisibility, MeterWarmStreak}
+var AllMeters = []Meter{MeterAntibioticCourse, MeterCampVisibility, MeterColdExposure, MeterCommunitySentiment, MeterCoolStreak, MeterCustomLastTurn, MeterCustomStreak, MeterExhaustionScenes, MeterFeverMedication, MeterFeverRest, MeterFortificationIntegrity, MeterHeatExposure, MeterHungerStreak, MeterHydrationRecovery, MeterInfectionPressure, MeterLeadershipTrust, MeterNoise, MeterPanicLevel, MeterRadiationExposure, MeterRestStreak, MeterScent, MeterSignalStrength, MeterStealthProfile, MeterSupplyBuffer, MeterSupplyOutlook, MeterThirstStreak, MeterTrust, MeterVisibility, MeterWarmStreak}
 
 type LocationType string
 
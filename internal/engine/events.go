@@ -23,12 +23,17 @@ type DirectorRequest struct {
 	TextDensity   string           `json:"text_density"`
 	Difficulty    Difficulty       `json:"difficulty"`
 	InfectedLocal bool             `json:"infected_local"`
+	// InfectedBehavior is how far the infected have adapted; Encounters holds the percent
+	// chance of each encounter kind. Available has already been thinned by those chances.
+	InfectedBehavior InfectedBehavior `json:"infected_behavior"`
+	Encounters       map[string]int   `json:"encounter_chances,omitempty"`
 }
 
 // HistorySnapshot conveys recent director decisions to help avoid repetition.
 type HistorySnapshot struct {
-	LastEvent string   `json:"last_event"`
-	Recent    []string `json:"recent"`
+	LastEvent string         `json:"last_event"`
+	Recent    []string       `json:"recent"`
+	Arcs      map[string]int `json:"arcs,omitempty"` // arc id -> last completed step
 }
 
 // DirectorPlan is the planner's response describing the next event and its choices.
@@ -45,6 +50,8 @@ type PlannedChoice struct {
 	Archetype string
 	Cost      PlanCost
 	Risk      string
+	Item      string       // optional medical item for medicate choices
+	Effects   ChoiceEffect // proposed effects; bounded by the archetype's budget before use
 }
 
 // PlanCost mirrors Choice cost inputs provided by the director.
@@ -57,13 +64,18 @@ type PlanCost struct {
 
 // EventBlueprint holds local metadata for an event (no narrative text).
 type EventBlueprint struct {
-	ID             string `json:"id"`
-	Name           string `json:"name"`
-	Tier           string `json:"tier"` // pre_arrival | post_arrival | any
-	Scale          string `json:"scale"`
-	Weight         int    `json:"weight"`
-	CooldownScenes int    `json:"cooldown_scenes"`
-	OncePerRun     bool   `json:"once_per_run"`
+	ID             string                    `json:"id" yaml:"id"`
+	Name           string                    `json:"name" yaml:"name"`
+	Tier           string                    `json:"tier" yaml:"tier"` // pre_arrival | post_arrival | any
+	Scale          string                    `json:"scale" yaml:"scale"`
+	Weight         int                       `json:"weight" yaml:"weight"`
+	CooldownScenes int                       `json:"cooldown_scenes" yaml:"cooldown_scenes"`
+	OncePerRun     bool                      `json:"once_per_run" yaml:"once_per_run,omitempty"`
+	ArcID          string                    `json:"arc_id,omitempty" yaml:"arc_id,omitempty"`     // arc this event belongs to
+	ArcStep        int                       `json:"arc_step,omitempty" yaml:"arc_step,omitempty"` // 1 opens the arc; steps sharing a number are alternative branches
+	Requires       EventRequirements         `json:"requires,omitempty" yaml:"requires,omitempty"`
+	Choices        map[string]ChoiceOverride `json:"-" yaml:"choices,omitempty"`                     // per-archetype tweaks to the generic profile
+	Encounter      string                    `json:"encounter,omitempty" yaml:"encounter,omitempty"` // "infected" or "hostile" when the event is an encounter
 }
 
 // EventContext tracks the executed event blueprint plus planner guidance.
@@ -81,7 +93,8 @@ type EventState struct {
 
 type EventHistory struct {
 	Events map[string]EventState
-	Recent []string // most recent first
+	Recent []string               // most recent first
+	Arcs   map[string]ArcProgress // per-survivor arc progress keyed by arc id
 }
 
 func (h EventHistory) eventState(id string) EventState {
@@ -95,7 +108,7 @@ func (h EventHistory) snapshot(limit int) HistorySnapshot {
 	if limit <= 0 {
 		limit = 5
 	}
-	snap := HistorySnapshot{}
+	snap := HistorySnapshot{Arcs: h.arcSteps()}
 	if len(h.Recent) == 0 {
 		return snap
 	}
@@ -108,22 +121,28 @@ func (h EventHistory) snapshot(limit int) HistorySnapshot {
 	return snap
 }
 
-// Catalog of available event blueprints.
-var eventCatalog = []EventBlueprint{
-	{ID: "urban_supply_scramble", Name: "Urban Supply Scramble", Tier: "pre_arrival", Scale: "minor", Weight: 6, CooldownScenes: 1},
-	{ID: "checkpoint_tension", Name: "Checkpoint Tension", Tier: "pre_arrival", Scale: "major", Weight: 3, CooldownScenes: 2},
-	{ID: "rolling_blackout", Name: "Rolling Blackout", Tier: "pre_arrival", Scale: "minor", Weight: 4, CooldownScenes: 2},
-	{ID: "crowd_panic", Name: "Crowd Panic Surge", Tier: "pre_arrival", Scale: "major", Weight: 2, CooldownScenes: 3},
-	{ID: "quiet_hour", Name: "Uneasy Quiet Hour", Tier: "any", Scale: "minor", Weight: 6, CooldownScenes: 1},
-	{ID: "radio_distress", Name: "Radio Distress Call", Tier: "any", Scale: "minor", Weight: 4, CooldownScenes: 2},
-	{ID: "supply_convoy", Name: "Supply Convoy Sighting", Tier: "any", Scale: "minor", Weight: 4, CooldownScenes: 2},
-	{ID: "makeshift_clinic", Name: "Makeshift Clinic", Tier: "any", Scale: "minor", Weight: 3, CooldownScenes: 2},
-	{ID: "rooftop_signal", Name: "Rooftop Signal", Tier: "post_arrival", Scale: "minor", Weight: 3, CooldownScenes: 2},
-	{ID: "neighborhood_breach", Name: "Neighborhood Breach", Tier: "post_arrival", Scale: "major", Weight: 2, CooldownScenes: 3},
-	{ID: "street_hunt", Name: "Street Hunt", Tier: "post_arrival", Scale: "major", Weight: 2, CooldownScenes: 3},
-	{ID: "hospital_overrun", Name: "Hospital Overrun", Tier: "post_arrival", Scale: "major", Weight: 1, CooldownScenes: 4, OncePerRun: true},
-	{ID: "abandoned_lab", Name: "Abandoned Lab Floor", Tier: "post_arrival", Scale: "major", Weight: 1, CooldownScenes: 4, OncePerRun: true},
-	{ID: "shelter_dynamics", Name: "Shelter Dynamics", Tier: "any", Scale: "minor", Weight: 5, CooldownScenes: 1},
+// Record notes that an event fired at sceneIdx, updating its cooldown, recency and arc progress.
+// Firing an arc's final step completes the arc and clears its progress.
+func (h *EventHistory) Record(bp EventBlueprint, sceneIdx int) {
+	if h.Events == nil {
+		h.Events = make(map[string]EventState)
+	}
+	h.Events[bp.ID] = EventState{
+		LastSceneIdx:       sceneIdx,
+		CooldownUntilScene: sceneIdx + bp.CooldownScenes + 1,
+		OnceFired:          bp.OncePerRun,
+	}
+	h.Recent = append([]string{bp.ID}, h.Recent...)
+	if bp.ArcID != "" {
+		if h.Arcs == nil {
+			h.Arcs = make(map[string]ArcProgress)
+		}
+		h.Arcs[bp.ArcID] = ArcProgress{Step: bp.ArcStep, LastSceneIdx: sceneIdx}
+		if bp.ArcStep >= arcFinalStep(bp.ArcID) {
+			// a finished arc can open again once its opener is off cooldown
+			delete(h.Arcs, bp.ArcID)
+		}
+	}
 }
 
 func catalogByID() map[string]EventBlueprint {
@@ -134,6 +153,15 @@ func catalogByID() map[string]EventBlueprint {
 	return out
 }
 
+func hasEvent(events []EventBlueprint, id string) bool {
+	for _, ev := range events {
+		if ev.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
 func availableEventBlueprints(s *Survivor, history EventHistory, sceneIdx int) []EventBlueprint {
 	preArrival := s.Environment.WorldDay < s.Environment.LAD
 	catalog := catalogByID()
@@ -155,6 +183,12 @@ func availableEventBlueprints(s *Survivor, history EventHistory, sceneIdx int) [
 		if state.CooldownUntilScene > sceneIdx {
 			continue
 		}
+		if !history.arcEligible(bp, sceneIdx) {
+			continue
+		}
+		if !bp.Requires.met(s) {
+			continue
+		}
 		switch strings.ToLower(bp.Tier) {
 		case "pre_arrival":
 			if !preArrival {
@@ -183,6 +217,10 @@ func GenerateChoices(ctx context.Context, planner DirectorPlanner, stream *Strea
 	if len(available) == 0 {
 		return nil, nil, errors.New("no eligible events for current state")
 	}
+	encounters := encounterChances(*s)
+	if stream != nil {
+		available = thinEncounters(available, encounters, stream.Child(fmt.Sprintf("encounters:%d", sceneIdx)))
+	}
 	req := DirectorRequest{
 		State:         s.NarrativeState(),
 		Available:     available,
@@ -192,6 +230,9 @@ func GenerateChoices(ctx context.Context, planner DirectorPlanner, stream *Strea
 		TextDensity:   cfg.textDensity,
 		Difficulty:    cfg.difficulty,
 		InfectedLocal: s.Environment.Infected,
+
+		InfectedBehavior: InfectedBehaviorOn(s.Environment.WorldDay),
+		Encounters:       encounters,
 	}
 	plan, err := planner.PlanEvent(ctx, req)
 	if err != nil {
@@ -219,18 +260,47 @@ func GenerateChoices(ctx context.Context, planner DirectorPlanner, stream *Strea
 	if state.CooldownUntilScene > sceneIdx {
 		return nil, nil, fmt.Errorf("planner selected event %q still on cooldown", bp.ID)
 	}
+	if !history.arcEligible(bp, sceneIdx) {
+		return nil, nil, fmt.Errorf("planner selected arc event %q out of sequence", bp.ID)
+	}
+	if !bp.Requires.met(s) {
+		return nil, nil, fmt.Errorf("planner selected event %q whose preconditions are not met", bp.ID)
+	}
+	if bp.Encounter != "" && !hasEvent(available, bp.ID) {
+		return nil, nil, fmt.Errorf("planner selected %s encounter %q that did not come up this scene", bp.Encounter, bp.ID)
+	}
 	if len(plan.Choices) < 2 || len(plan.Choices) > 6 {
 		return nil, nil, fmt.Errorf("planner returned %d choices (must be 2-6)", len(plan.Choices))
 	}
 	choices := make([]Choice, 0, len(plan.Choices))
 	for i, pc := range plan.Choices {
-		choice, err := buildChoiceFromPlan(bp.ID, i, pc)
+		choice, err := buildChoiceFromPlan(bp, len(choices), pc)
 		if err != nil {
 			return nil, nil, fmt.Errorf("choice %d invalid: %w", i, err)
 		}
+		accepted, rejected := boundProposedEffects(pc.Effects, choice.Archetype, cfg.difficulty, *s)
+		choice.Effects = mergeEffects(choice.Effects, accepted)
+		choice.Rejected = rejected
+		if choice.Archetype == "travel" {
+			// planned travel follows a real leg; its time and effort come from the route
+			var routeStream *Stream
+			if stream != nil {
+				routeStream = stream.Child(fmt.Sprintf("route:%d:%d", sceneIdx, i))
+			}
+			leg, ok := pickRoute(*s, cfg.world.regionGraph(), routeStream)
+			if !ok {
+				// nowhere the survivor can reach: a travel choice would cost time and go nowhere
+				continue
+			}
+			choice.Route, choice.Cost = leg.Route, leg.Cost
+			choice.Label = fmt.Sprintf("%s (%s)", choice.Label, leg.Route.Describe())
+		}
 		adjustRisk(&choice, *s, cfg)
 		choices = append(choices, choice)
 	}
+	if len(choices) < 2 {
+		return nil, nil, fmt.Errorf("only %d of the planned choices can be taken", len(choices))
+	}
 	ctxOut := &EventContext{
 		Event:    bp,
 		Guidance: plan.Guidance,
@@ -239,70 +309,9 @@ func GenerateChoices(ctx context.Context, planner DirectorPlanner, stream *Strea
 	return choices, ctxOut, nil
 }
 
-type archetypeProfile struct {
-	BaseOutcome ChoiceOutcome
-	BaseEffects ChoiceEffect
-	BaseCost    Cost
-}
-
-var archetypeProfiles = map[string]archetypeProfile{
-	"rest": {
-		BaseOutcome: ChoiceOutcome{
-			StatFatigue: {Min: -12, Max: -8},
-			StatMorale:  {Min: 1, Max: 2},
-		},
-		BaseCost: Cost{Time: 1},
-	},
-	"forage": {
-		BaseOutcome: ChoiceOutcome{
-			StatHunger:  {Min: -8, Max: -5},
-			StatThirst:  {Min: -6, Max: -3},
-			StatFatigue: {Min: 4, Max: 7},
-		},
-		BaseCost: Cost{Time: 1, Fatigue: 3},
-	},
-	"scout": {
-		BaseOutcome: ChoiceOutcome{
-			StatFatigue: {Min: 5, Max: 8},
-			StatMorale:  {Min: 1, Max: 2},
-		},
-		BaseCost: Cost{Time: 1, Fatigue: 4},
-	},
-	"organize": {
-		BaseOutcome: ChoiceOutcome{
-			StatMorale: {Min: 2, Max: 4},
-		},
-		BaseCost: Cost{Time: 1},
-	},
-	"barricade": {
-		BaseOutcome: ChoiceOutcome{
-			StatFatigue: {Min: 6, Max: 9},
-		},
-		BaseCost: Cost{Time: 1, Fatigue: 5},
-	},
-	"craft": {
-		BaseOutcome: ChoiceOutcome{
-			StatFatigue: {Min: 4, Max: 7},
-			StatMorale:  {Min: 1, Max: 2},
-		},
-		BaseCost: Cost{Time: 1, Fatigue: 4},
-	},
-	"diplomacy": {
-		BaseOutcome: ChoiceOutcome{
-			StatMorale: {Min: 2, Max: 4},
-		},
-		BaseCost: Cost{Time: 1},
-	},
-	"observe": {
-		BaseOutcome: ChoiceOutcome{
-			StatFatigue: {Min: 3, Max: 5},
-			StatMorale:  {Min: 1, Max: 1},
-		},
-		BaseCost: Cost{Time: 1, Fatigue: 2},
-	},
-}
-
-func buildChoiceFromPlan(eventID string, idx int, pc PlannedChoice) (Choice, error) {
+// buildChoiceFromPlan starts from the archetype's generic profile and layers the event's
+// override for that archetype, if any, on top.
+func buildChoiceFromPlan(bp EventBlueprint, idx int, pc PlannedChoice) (Choice, error) {
 	label := strings.TrimSpace(pc.Label)
 	if label == "" {
 		return Choice{}, errors.New("label empty")
@@ -317,25 +326,36 @@ func buildChoiceFromPlan(eventID string, idx int, pc PlannedChoice) (Choice, err
 		return Choice{}, err
 	}
 	cost := profile.BaseCost
-	cost.Time = clampMin(pc.Cost.Time, 1, cost.Time)
+	cost.Time = min(clampMin(pc.Cost.Time, 1, cost.Time), maxPlannedTime(profile))
 	cost.Fatigue = clampWithDefault(pc.Cost.Fatigue, cost.Fatigue)
 	cost.Hunger = clampWithDefault(pc.Cost.Hunger, cost.Hunger)
 	cost.Thirst = clampWithDefault(pc.Cost.Thirst, cost.Thirst)
-	outcome := cloneOutcome(profile.BaseOutcome)
+	override := bp.Choices[archetype]
+	risk = shiftRisk(risk, override.RiskShift)
+	outcome := override.apply(profile.BaseOutcome)
 	choice := Choice{
 		Index:       idx,
-		ID:          fmt.Sprintf("%s:%d", eventID, idx),
+		ID:          fmt.Sprintf("%s:%d", bp.ID, idx),
 		Label:       label,
 		Cost:        cost,
 		Risk:        risk,
 		Archetype:   archetype,
 		Outcome:     outcome,
-		Effects:     profile.BaseEffects,
-		SourceEvent: eventID,
+		Effects:     mergeEffects(profile.BaseEffects, override.Effects),
+		SourceEvent: bp.ID,
+		Item:        strings.ToLower(strings.TrimSpace(pc.Item)),
 	}
 	return choice, nil
 }
 
+// plannedTimeFactor bounds how much longer than its base cost a director may make a choice:
+// time moves the clock and draws supplies, so a runaway value would skip whole days.
+const plannedTimeFactor = 3
+
+func maxPlannedTime(p archetypeProfile) int {
+	return max(p.BaseCost.Time, 1) * plannedTimeFactor
+}
+
 func riskFromString(raw string) (RiskLevel, error) {
 	switch strings.ToLower(strings.TrimSpace(raw)) {
 	case "low", "risk_low", "r1", "":
@@ -379,13 +399,3 @@ func cloneOutcome(src ChoiceOutcome) ChoiceOutcome {
 	}
 	return out
 }
-
-// AllowedArchetypes returns the ordered list of archetypes supported by planner choices.
-func AllowedArchetypes() []string {
-	keys := make([]string, 0, len(archetypeProfiles))
-	for k := range archetypeProfiles {
-		keys = append(keys, k)
-	}
-	sort.Strings(keys)
-	return keys
-}
@@ -23,7 +23,7 @@ func (s *stubPlanner) PlanEvent(ctx context.Context, req DirectorRequest) (Direc
 
 func TestAvailableEventBlueprints_PreArrivalFilters(t *testing.T) {
 	seed, _ := NewRunSeed("pre-arrival-filter")
-	survivor := NewFirstSurvivor(seed.Stream("survivor"), "USAMRIID/Fort Detrick (USA)")
+	survivor := NewFirstSurvivor(seed.Stream("survivor"), seed, "USAMRIID/Fort Detrick (USA)")
 	survivor.Environment.WorldDay = 0
 	survivor.Environment.LAD = 5
 	survivor.updateInfectionPresence()
@@ -55,7 +55,7 @@ func TestAvailableEventBlueprints_PreArrivalFilters(t *testing.T) {
 
 func TestGenerateChoices_UsesPlannerPlan(t *testing.T) {
 	seed, _ := NewRunSeed("planner-success")
-	survivor := NewFirstSurvivor(seed.Stream("sv"), "USAMRIID/Fort Detrick (USA)")
+	survivor := NewFirstSurvivor(seed.Stream("sv"), seed, "USAMRIID/Fort Detrick (USA)")
 	survivor.Environment.WorldDay = 5
 	survivor.Environment.LAD = 5
 	survivor.updateInfectionPresence()
@@ -113,7 +113,7 @@ func TestGenerateChoices_UsesPlannerPlan(t *testing.T) {
 
 func TestGenerateChoicesRejectsUnknownEvent(t *testing.T) {
 	seed, _ := NewRunSeed("planner-error")
-	survivor := NewFirstSurvivor(seed.Stream("sv"), "USAMRIID/Fort Detrick (USA)")
+	survivor := NewFirstSurvivor(seed.Stream("sv"), seed, "USAMRIID/Fort Detrick (USA)")
 	planner := &stubPlanner{
 		plan: DirectorPlan{
 			EventID: "made_up_event",
@@ -0,0 +1,105 @@
+package engine
+
+// exposureMeters are the signature meters the infected and hostile survivors home in on.
+var exposureMeters = []Meter{MeterNoise, MeterVisibility, MeterScent}
+
+// exposureDecay is the percent of each signature meter that fades per time unit in calm
+// conditions: sound is gone quickly, a trail of scent lingers.
+var exposureDecay = map[Meter]int{
+	MeterNoise:      40,
+	MeterVisibility: 25,
+	MeterScent:      10,
+}
+
+// exposureConditions reshape the survivor's signature: Dampen is the percent of a fresh
+// increase that is masked, Decay is extra percent fading per time unit.
+type exposureConditions struct {
+	Dampen map[Meter]int
+	Decay  map[Meter]int
+}
+
+// weatherExposure: rain and snow wash out scent, wind covers sound, murk hides movement.
+var weatherExposure = map[Weather]exposureConditions{
+	WeatherRain:      {Dampen: map[Meter]int{MeterScent: 50}, Decay: map[Meter]int{MeterScent: 30}},
+	WeatherMonsoon:   {Dampen: map[Meter]int{MeterScent: 75, MeterNoise: 25}, Decay: map[Meter]int{MeterScent: 50}},
+	WeatherStorm:     {Dampen: map[Meter]int{MeterNoise: 50, MeterScent: 50}, Decay: map[Meter]int{MeterScent: 30, MeterNoise: 20}},
+	WeatherSnow:      {Dampen: map[Meter]int{MeterScent: 25}, Decay: map[Meter]int{MeterScent: 20}},
+	WeatherBlizzard:  {Dampen: map[Meter]int{MeterNoise: 50, MeterVisibility: 50, MeterScent: 50}, Decay: map[Meter]int{MeterScent: 30, MeterNoise: 20}},
+	WeatherFog:       {Dampen: map[Meter]int{MeterVisibility: 50}, Decay: map[Meter]int{MeterVisibility: 20}},
+	WeatherSmoke:     {Dampen: map[Meter]int{MeterVisibility: 40, MeterScent: 25}},
+	WeatherDustStorm: {Dampen: map[Meter]int{MeterVisibility: 50, MeterNoise: 25}, Decay: map[Meter]int{MeterVisibility: 20}},
+	WeatherAshfall:   {Dampen: map[Meter]int{MeterVisibility: 25}},
+	WeatherHeatwave:  {Decay: map[Meter]int{MeterScent: -5}}, // heat carries scent further
+}
+
+// timeExposure: darkness hides movement, still night air carries sound.
+var timeExposure = map[string]exposureConditions{
+	"pre-dawn": {Dampen: map[Meter]int{MeterVisibility: 50, MeterNoise: -25}, Decay: map[Meter]int{MeterVisibility: 20}},
+	"evening":  {Dampen: map[Meter]int{MeterVisibility: 25}},
+	"night":    {Dampen: map[Meter]int{MeterVisibility: 60, MeterNoise: -25}, Decay: map[Meter]int{MeterVisibility: 25}},
+}
+
+// exposureEffect dampens the signature increases in an effect for the survivor's weather and
+// time of day. Decreases pass through untouched.
+func exposureEffect(eff ChoiceEffect, env Environment) ChoiceEffect {
+	if len(eff.MeterDeltas) == 0 {
+		return eff
+	}
+	out := eff
+	out.MeterDeltas = make(map[Meter]int, len(eff.MeterDeltas))
+	for m, d := range eff.MeterDeltas {
+		if d > 0 {
+			dampen := weatherExposure[env.Weather].Dampen[m] + timeExposure[env.TimeOfDay].Dampen[m]
+			d = d * (100 - clampInt(dampen, -100, 100)) / 100
+		}
+		out.MeterDeltas[m] = d
+	}
+	return out
+}
+
+// decayExposure fades the survivor's signature over the given time units, faster in weather
+// and darkness that erase it.
+func decayExposure(s *Survivor, units int) {
+	if s == nil || units <= 0 {
+		return
+	}
+	if s.Meters == nil {
+		s.Meters = make(map[Meter]int)
+	}
+	for _, m := range exposureMeters {
+		rate := exposureDecay[m] + weatherExposure[s.Environment.Weather].Decay[m] + timeExposure[s.Environment.TimeOfDay].Decay[m]
+		rate = clampInt(rate, 0, 100)
+		v := s.Meters[m]
+		for i := 0; i < units && v > 0; i++ {
+			v -= (v*rate + 99) / 100
+		}
+		s.Meters[m] = clampInt(v, 0, 100)
+	}
+}
+
+// Exposure combines noise, visibility and scent into one 0-100 signature. Noise and
+// visibility carry most weight; scent only matters up close.
+func Exposure(s Survivor) int {
+	return clampInt((2*s.Meters[MeterNoise]+2*s.Meters[MeterVisibility]+s.Meters[MeterScent])/5, 0, 100)
+}
+
+// exposureScaled scales an encounter chance by the survivor's signature: half when they leave
+// no trace, up to one and a half times when they are impossible to miss.
+func exposureScaled(chance int, s Survivor) int {
+	return clampInt(chance*(50+Exposure(s))/100, 0, 100)
+}
+
+// Hostile survivors are about from the start and multiply once order breaks down.
+const (
+	hostileBaseChance         = 20
+	hostileBaseChanceCollapse = 40
+)
+
+// HostileEncounterChance is the percent chance hostile survivors find the survivor in a scene.
+func HostileEncounterChance(s Survivor) int {
+	base := hostileBaseChance
+	if s.Environment.Infected {
+		base = hostileBaseChanceCollapse
+	}
+	return exposureScaled(base, s)
+}
@@ -0,0 +1,68 @@
+package engine
+
+import "testing"
+
+// clearMidday puts a test survivor out in clear weather at midday, where nothing masks them.
+func clearMidday(s *Survivor) {
+	s.Environment.Weather, s.Environment.TimeOfDay = WeatherClear, "midday"
+}
+
+func TestBarricadingIsLoudAndFades(t *testing.T) {
+	s := testSurvivor("exposure", clearMidday)
+	c := Choice{Archetype: "barricade", Risk: RiskLow, Cost: Cost{Time: 1}, Effects: archetypeProfiles["barricade"].BaseEffects}
+	ApplyChoice(&s, c, DifficultyStandard, 1, newStream(SeedFromString("barricade")))
+	loud := s.Meters[MeterNoise]
+	if loud <= 0 {
+		t.Fatalf("expected barricading to raise noise, got %d", loud)
+	}
+	decayExposure(&s, 2)
+	if s.Meters[MeterNoise] >= loud {
+		t.Fatalf("expected noise to fade over time (%d -> %d)", loud, s.Meters[MeterNoise])
+	}
+	decayExposure(&s, 20)
+	if s.Meters[MeterNoise] != 0 {
+		t.Fatalf("expected noise to die out, got %d", s.Meters[MeterNoise])
+	}
+}
+
+func TestWeatherAndTimeShapeExposure(t *testing.T) {
+	eff := ChoiceEffect{MeterDeltas: map[Meter]int{MeterScent: 20, MeterVisibility: 20, MeterNoise: -5}}
+	calm := exposureEffect(eff, Environment{Weather: WeatherClear, TimeOfDay: "midday"})
+	rain := exposureEffect(eff, Environment{Weather: WeatherRain, TimeOfDay: "midday"})
+	night := exposureEffect(eff, Environment{Weather: WeatherClear, TimeOfDay: "night"})
+	if rain.MeterDeltas[MeterScent] >= calm.MeterDeltas[MeterScent] {
+		t.Fatalf("expected rain to mask scent: %v vs %v", rain.MeterDeltas, calm.MeterDeltas)
+	}
+	if night.MeterDeltas[MeterVisibility] >= calm.MeterDeltas[MeterVisibility] {
+		t.Fatalf("expected night to lower visibility: %v vs %v", night.MeterDeltas, calm.MeterDeltas)
+	}
+	if rain.MeterDeltas[MeterNoise] != -5 || eff.MeterDeltas[MeterScent] != 20 {
+		t.Fatalf("expected decreases to pass through and the source effect to stay untouched")
+	}
+
+	dry, wet := testSurvivor("exposure", clearMidday), testSurvivor("exposure", clearMidday)
+	dry.Meters[MeterScent], wet.Meters[MeterScent] = 60, 60
+	wet.Environment.Weather = WeatherRain
+	decayExposure(&dry, 1)
+	decayExposure(&wet, 1)
+	if wet.Meters[MeterScent] >= dry.Meters[MeterScent] {
+		t.Fatalf("expected rain to wash scent away faster (%d vs %d)", wet.Meters[MeterScent], dry.Meters[MeterScent])
+	}
+}
+
+func TestExposureDrivesEncounterChances(t *testing.T) {
+	s := testSurvivor("exposure", clearMidday)
+	s.SyncEnvironmentDay(s.Environment.LAD + 40)
+	quiet := encounterChances(s)
+	s.Meters[MeterNoise], s.Meters[MeterVisibility], s.Meters[MeterScent] = 80, 70, 50
+	loud := encounterChances(s)
+	for _, kind := range []string{"infected", "hostile"} {
+		if loud[kind] <= quiet[kind] {
+			t.Fatalf("expected a louder survivor to draw more %s encounters (%d -> %d)", kind, quiet[kind], loud[kind])
+		}
+	}
+	s.SyncEnvironmentDay(s.Environment.LAD - 1)
+	if c := encounterChances(s); c["infected"] != 0 || c["hostile"] == 0 {
+		t.Fatalf("expected only hostile encounters before LAD, got %v", c)
+	}
+}
@@ -0,0 +1,172 @@
+package engine
+
+// InfectedTier names how far the infected have adapted since the outbreak.
+type InfectedTier string
+
+const (
+	InfectedShambling   InfectedTier = "shambling"   // slow, uncoordinated, drawn by noise
+	InfectedRestless    InfectedTier = "restless"    // quicker; track movement and light
+	InfectedProbing     InfectedTier = "probing"     // test doors and barricades, flank stragglers
+	InfectedCoordinated InfectedTier = "coordinated" // fast and probing, with primitive coordination
+)
+
+// adaptationDays is the world day by which the infected have fully adapted (canon: ~Day 300).
+const adaptationDays = 300
+
+// infectedTiers maps adaptation (0-100) to behaviour, lowest threshold first.
+var infectedTiers = []struct {
+	From  int
+	Tier  InfectedTier
+	Notes string
+}{
+	{0, InfectedShambling, "slow and uncoordinated; drawn to noise"},
+	{25, InfectedRestless, "quicker; follow movement and light"},
+	{50, InfectedProbing, "test barriers and doors; flank stragglers"},
+	{85, InfectedCoordinated, "fast and probing; move in loose, coordinated groups"},
+}
+
+// InfectedBehavior describes the infected on a world day, for the director and narrator.
+type InfectedBehavior struct {
+	Tier       InfectedTier `json:"tier"`
+	Adaptation int          `json:"adaptation"` // 0-100, linear over adaptationDays
+	Notes      string       `json:"notes"`
+}
+
+// InfectedBehaviorOn returns the infected adaptation reached by a world day.
+func InfectedBehaviorOn(worldDay int) InfectedBehavior {
+	adaptation := clampInt(worldDay*100/adaptationDays, 0, 100)
+	b := InfectedBehavior{Adaptation: adaptation}
+	for _, t := range infectedTiers {
+		if adaptation >= t.From {
+			b.Tier, b.Notes = t.Tier, t.Notes
+		}
+	}
+	return b
+}
+
+const (
+	pressureHalfDays      = 30 // days after LAD for density to reach half in built-up regions
+	ruralPressureHalfDays = 60
+	pressureRiskStep      = 33 // pressure at which work outside carries one more risk tier (~2 weeks in)
+)
+
+// infectionPressure is infected density (0-100) for a region some days after its LAD. It
+// starts low and localized and climbs toward saturation; rural regions fill more slowly.
+func infectionPressure(daysSinceLAD int, rural bool) int {
+	if daysSinceLAD < 0 {
+		return 0
+	}
+	half := float64(pressureHalfDays)
+	if rural {
+		half = ruralPressureHalfDays
+	}
+	d := float64(daysSinceLAD + 1)
+	return clampInt(int(100*d/(d+half)), 0, 100)
+}
+
+// InfectionPressure returns a region's infected density on a world day.
+func (g *RegionGraph) InfectionPressure(region string, day int) int {
+	n, ok := g.NodeByName(region)
+	if !ok {
+		return 0
+	}
+	return infectionPressure(day-n.LAD, n.Rural)
+}
+
+// syncInfection refreshes infection presence and the pressure meter for the survivor's day
+// and place. Without a graph the region is treated as built-up.
+func (s *Survivor) syncInfection(regions *RegionGraph) {
+	s.Environment.Infected = s.Environment.WorldDay >= s.Environment.LAD
+	rural := false
+	if regions != nil {
+		if n, ok := regions.NodeByName(s.Region); ok {
+			rural = n.Rural
+		}
+	}
+	if s.Meters == nil {
+		s.Meters = make(map[Meter]int)
+	}
+	s.Meters[MeterInfectionPressure] = infectionPressure(s.Environment.WorldDay-s.Environment.LAD, rural)
+}
+
+// SyncInfection updates the survivor's infection presence and pressure from the world's
+// region graph. Call it after spawning a survivor; the clock calls it on every day rollover.
+func (w *World) SyncInfection(s *Survivor) {
+	if s == nil {
+		return
+	}
+	s.syncInfection(w.regionGraph())
+}
+
+// survivorPressure reads the pressure meter, falling back to the built-up curve for
+// survivors that have not been synced.
+func survivorPressure(s Survivor) int {
+	if p, ok := s.Meters[MeterInfectionPressure]; ok {
+		return p
+	}
+	return infectionPressure(s.Environment.WorldDay-s.Environment.LAD, false)
+}
+
+// infectionRiskShift is the risk added by local density and infected adaptation: one tier
+// once pressure builds, and one more for exposed work once the infected coordinate.
+func infectionRiskShift(s Survivor, archetype string) int {
+	if !s.Environment.Infected || archetype == "rest" {
+		return 0
+	}
+	shift := 0
+	if survivorPressure(s) >= pressureRiskStep {
+		shift++
+	}
+	cat := archetypeCategory(archetype)
+	if InfectedBehaviorOn(s.Environment.WorldDay).Tier == InfectedCoordinated && (cat == "physical" || cat == "stealth") {
+		shift++
+	}
+	return shift
+}
+
+// InfectedEncounterChance is the percent chance the infected find the survivor in a scene:
+// local pressure, sharpened as the infected adapt and scaled by the survivor's exposure.
+// Zero before LAD.
+func InfectedEncounterChance(s Survivor) int {
+	if !s.Environment.Infected {
+		return 0
+	}
+	b := InfectedBehaviorOn(s.Environment.WorldDay)
+	return exposureScaled(survivorPressure(s)*(100+b.Adaptation)/200, s)
+}
+
+// thinEncounters rolls each encounter kind once for the scene and drops the events tagged
+// with a kind that didn't come up, so every planner offers encounters at their real chance.
+// Without a stream nothing is dropped; if nothing would be left the list is kept whole.
+func thinEncounters(available []EventBlueprint, chances map[string]int, stream *Stream) []EventBlueprint {
+	if stream == nil {
+		return available
+	}
+	met := map[string]bool{}
+	out := make([]EventBlueprint, 0, len(available))
+	for _, bp := range available {
+		if bp.Encounter != "" {
+			ok, rolled := met[bp.Encounter]
+			if !rolled {
+				ok = stream.Child(bp.Encounter).Intn(100) < chances[bp.Encounter]
+				met[bp.Encounter] = ok
+			}
+			if !ok {
+				continue
+			}
+		}
+		out = append(out, bp)
+	}
+	if len(out) == 0 {
+		return available
+	}
+	return out
+}
+
+// encounterChances collects the chance of each encounter kind coming up in a scene.
+func encounterChances(s Survivor) map[string]int {
+	return map[string]int{
+		"infected": InfectedEncounterChance(s),
+		"hostile":  HostileEncounterChance(s),
+	}
+}
@@ -0,0 +1,135 @@
+package engine
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestInfectedAdaptationTiersAdvance(t *testing.T) {
+	cases := []struct {
+		day  int
+		tier InfectedTier
+	}{
+		{0, InfectedShambling},
+		{74, InfectedShambling},
+		{75, InfectedRestless},
+		{150, InfectedProbing},
+		{255, InfectedCoordinated},
+		{900, InfectedCoordinated},
+	}
+	for _, c := range cases {
+		b := InfectedBehaviorOn(c.day)
+		if b.Tier != c.tier || b.Notes == "" {
+			t.Fatalf("day %d: expected %s, got %+v", c.day, c.tier, b)
+		}
+	}
+	if b := InfectedBehaviorOn(adaptationDays); b.Adaptation != 100 {
+		t.Fatalf("expected full adaptation by day %d, got %d", adaptationDays, b.Adaptation)
+	}
+}
+
+func TestInfectionPressureClimbsAndRuralLags(t *testing.T) {
+	if p := infectionPressure(-1, false); p != 0 {
+		t.Fatalf("expected no pressure before LAD, got %d", p)
+	}
+	prev := 0
+	for _, d := range []int{0, 7, 30, 90, 365} {
+		p := infectionPressure(d, false)
+		if p <= prev && d > 0 {
+			t.Fatalf("expected pressure to climb, day %d gave %d after %d", d, p, prev)
+		}
+		if r := infectionPressure(d, true); r > p {
+			t.Fatalf("day %d: rural pressure %d above built-up %d", d, r, p)
+		}
+		prev = p
+	}
+	if infectionPressure(0, false) > 5 {
+		t.Fatalf("expected arrival-day pressure to start low")
+	}
+}
+
+func TestInfectionRiskTracksPressure(t *testing.T) {
+	s := testSurvivor("infection-risk")
+	s.SyncEnvironmentDay(s.Environment.LAD + 2)
+	early := infectionRiskShift(s, "scout")
+	s.SyncEnvironmentDay(s.Environment.LAD + 40)
+	if s.Meters[MeterInfectionPressure] < pressureRiskStep {
+		t.Fatalf("expected pressure past the risk step after 40 days, got %d", s.Meters[MeterInfectionPressure])
+	}
+	if late := infectionRiskShift(s, "scout"); late <= early {
+		t.Fatalf("expected scouting to grow riskier as pressure builds (%d -> %d)", early, late)
+	}
+	if infectionRiskShift(s, "rest") != 0 {
+		t.Fatalf("expected resting to carry no infected risk")
+	}
+}
+
+func TestCustomActionsCarryInfectionRisk(t *testing.T) {
+	s := testSurvivor("infection-custom")
+	s.Traits = nil
+	s.Meters[MeterCustomLastTurn] = -10
+	s.SyncEnvironmentDay(s.Environment.LAD + 2)
+	early, ok, reason := ValidateCustomAction("scout the ridge", s, 1, DifficultyStandard)
+	if !ok {
+		t.Fatalf("expected scouting allowed: %s", reason)
+	}
+	s.SyncEnvironmentDay(s.Environment.LAD + 40)
+	late, _, _ := ValidateCustomAction("scout the ridge", s, 1, DifficultyStandard)
+	if riskScore(late.Risk) <= riskScore(early.Risk) {
+		t.Fatalf("expected a custom scout to grow riskier as pressure builds (%s -> %s)", early.Risk, late.Risk)
+	}
+}
+
+func TestEncounterChanceThinsEncounterEvents(t *testing.T) {
+	horde := EventBlueprint{ID: "horde", Name: "Horde", Scale: "major", Weight: 3, Encounter: "infected"}
+	raid := EventBlueprint{ID: "raid", Name: "Raid", Scale: "major", Weight: 3, Encounter: "hostile"}
+	quiet := EventBlueprint{ID: "quiet", Name: "Quiet", Scale: "minor", Weight: 3}
+	kept := func(chance int) int {
+		seed, _ := NewRunSeed("encounters")
+		n := 0
+		for i := 0; i < 300; i++ {
+			out := thinEncounters([]EventBlueprint{horde, raid, quiet}, map[string]int{"infected": chance, "hostile": 50}, seed.Stream(fmt.Sprintf("p:%d", i)))
+			if !hasEvent(out, "quiet") {
+				t.Fatalf("expected events without an encounter to stay")
+			}
+			if hasEvent(out, "horde") {
+				n++
+			}
+		}
+		return n
+	}
+	if none, all := kept(0), kept(100); none != 0 || all != 300 {
+		t.Fatalf("expected infected events offered at their chance, got %d at 0%% and %d at 100%%", none, all)
+	}
+	if half := kept(50); half < 100 || half > 200 {
+		t.Fatalf("expected about half the scenes to offer the horde at 50%%, got %d/300", half)
+	}
+	only := thinEncounters([]EventBlueprint{horde}, map[string]int{"infected": 0}, newStream(SeedFromString("only")))
+	if len(only) != 1 {
+		t.Fatalf("expected the list kept whole when thinning would empty it")
+	}
+
+	s := testSurvivor("encounter-chance")
+	s.SyncEnvironmentDay(s.Environment.LAD - 1)
+	if InfectedEncounterChance(s) != 0 {
+		t.Fatalf("expected no encounters before LAD")
+	}
+	s.SyncEnvironmentDay(s.Environment.LAD + 30)
+	early := InfectedEncounterChance(s)
+	s.SyncEnvironmentDay(s.Environment.LAD + 280)
+	if late := InfectedEncounterChance(s); late <= early {
+		t.Fatalf("expected adapted infected to find the survivor more often (%d -> %d)", early, late)
+	}
+}
+
+func TestNarrativeStateReportsInfectedBehaviorAfterArrival(t *testing.T) {
+	s := testSurvivor("infection-narrative")
+	s.SyncEnvironmentDay(s.Environment.LAD - 1)
+	if _, ok := s.NarrativeState()["infected_behavior"]; ok {
+		t.Fatalf("expected no infected behaviour before arrival")
+	}
+	s.SyncEnvironmentDay(s.Environment.LAD + 3)
+	if b, ok := s.NarrativeState()["infected_behavior"].(InfectedBehavior); !ok || b.Tier == "" {
+		t.Fatalf("expected infected behaviour once infected are present")
+	}
+}
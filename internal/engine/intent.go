@@ -0,0 +1,324 @@
+package engine
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Intent is a custom action parsed from free text.
+type Intent struct {
+	Archetype  string
+	Verb       string   // phrase that resolved the archetype, as typed
+	Items      []string // carried items the input mentions
+	Target     string   // what the verb acts on, e.g. "river crossing"
+	Negated    bool     // the only actions named were negated ("don't fight")
+	Confidence float64  // 0-1; low when several archetypes compete or no verb leads the sentence
+}
+
+// minIntentConfidence is the confidence below which a custom action is too ambiguous to run.
+const minIntentConfidence = 0.4
+
+// intentSynonyms extends the archetype keywords with everyday verbs and phrasings.
+var intentSynonyms = map[string][]string{
+	"rest":      {"relax", "doze", "lie down", "catch my breath", "take a break"},
+	"forage":    {"find", "loot", "collect", "rummage", "raid"},
+	"scout":     {"explore", "look around", "check out", "reconnoiter"},
+	"organize":  {"tidy", "inventory", "ration", "plan", "take stock"},
+	"barricade": {"reinforce", "fortify", "block", "nail shut"},
+	"craft":     {"make", "assemble", "whittle", "fashion", "sharpen"},
+	"diplomacy": {"talk", "convince", "persuade", "ask", "plead", "calm"},
+	"observe":   {"wait", "study", "monitor", "keep an eye on", "stake out"},
+	"medicate":  {"treat", "heal", "stitch", "clean the wound", "dress the wound"},
+	"travel":    {"leave", "walk", "drive", "ride", "sail", "cross", "flee"},
+	"trade":     {"exchange", "sell", "buy"},
+	"fight":     {"defend", "punch", "bash", "strike", "fend off"},
+	"hide":      {"sneak", "conceal", "keep quiet", "go to ground"},
+	"hunt":      {"trap", "stalk game"},
+	"repair":    {"maintain", "patch", "restore", "service"},
+	"signal":    {"broadcast", "transmit", "wave down", "flash"},
+}
+
+// intentIdioms are figures of speech that contain action verbs but ask for nothing.
+var intentIdioms = []string{
+	"rest my hopes", "rest our hopes", "rest assured", "fight the urge", "fight back tears",
+	"hide my fear", "hide a smile", "watch my words",
+}
+
+var (
+	negators = map[string]bool{"not": true, "dont": true, "never": true, "no": true, "cant": true, "cannot": true, "wont": true, "avoid": true, "without": true}
+	// adjacentNegators only negate an action named right after them: "stop fighting" is
+	// negated, "stop the bleeding" is not.
+	adjacentNegators = map[string]bool{"stop": true}
+	negatorPhrases   = [][]string{{"instead", "of"}, {"rather", "than"}}
+	// intentFillers may precede the main verb without costing it the lead position.
+	intentFillers = map[string]bool{"i": true, "we": true, "lets": true, "let": true, "us": true, "ll": true, "will": true,
+		"want": true, "to": true, "try": true, "should": true, "could": true, "going": true, "gonna": true, "go": true,
+		"quickly": true, "carefully": true, "quietly": true, "just": true, "now": true, "please": true}
+	// targetSkips are dropped from the front of a target phrase.
+	targetSkips = map[string]bool{"the": true, "a": true, "an": true, "to": true, "at": true, "for": true, "on": true,
+		"with": true, "my": true, "our": true, "some": true, "into": true, "in": true, "off": true, "up": true, "out": true}
+	clauseBreaks = map[string]bool{"and": true, "then": true, "but": true, "or": true}
+)
+
+const maxTargetWords = 4
+
+// intentPhrase is a stemmed token sequence that resolves to an archetype ("" for idioms).
+type intentPhrase struct {
+	tokens    []string
+	archetype string
+}
+
+var intentPhrases = buildIntentPhrases()
+
+// buildIntentPhrases collects keywords, synonyms and idioms, longest first so
+// "patch up" beats "patch" and idioms beat the verbs inside them.
+func buildIntentPhrases() []intentPhrase {
+	var out []intentPhrase
+	add := func(raw, archetype string) {
+		toks := stemAll(tokenize(raw))
+		if len(toks) > 0 {
+			out = append(out, intentPhrase{tokens: toks, archetype: archetype})
+		}
+	}
+	for _, idiom := range intentIdioms {
+		add(idiom, "")
+	}
+	for _, a := range customMatchOrder {
+		for _, kw := range archetypeProfiles[a].Keywords {
+			add(kw, a)
+		}
+		for _, syn := range intentSynonyms[a] {
+			add(syn, a)
+		}
+	}
+	// stable insertion sort by length keeps keyword order among equals
+	for i := 1; i < len(out); i++ {
+		for j := i; j > 0 && len(out[j].tokens) > len(out[j-1].tokens); j-- {
+			out[j], out[j-1] = out[j-1], out[j]
+		}
+	}
+	return out
+}
+
+// tokenize lowercases, drops apostrophes ("don't" -> "dont") and splits on anything else
+// that isn't a letter or digit. Clause punctuation survives as "," tokens.
+func tokenize(in string) []string {
+	in = strings.ToLower(strings.NewReplacer("'", "", "’", "").Replace(in))
+	var toks []string
+	var b strings.Builder
+	flush := func() {
+		if b.Len() > 0 {
+			toks = append(toks, b.String())
+			b.Reset()
+		}
+	}
+	for _, r := range in {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		case strings.ContainsRune(",.;!?", r):
+			flush()
+			toks = append(toks, ",")
+		default:
+			flush()
+		}
+	}
+	flush()
+	return toks
+}
+
+// stem strips common inflections so "searching", "searched" and "searches" meet "search".
+func stem(w string) string {
+	for _, suf := range []string{"ing", "ed", "es", "s"} {
+		if strings.HasSuffix(w, suf) && len(w)-len(suf) >= 3 && !(suf == "s" && strings.HasSuffix(w, "ss")) {
+			w = w[:len(w)-len(suf)]
+			break
+		}
+	}
+	if len(w) > 3 {
+		w = strings.TrimSuffix(w, "e")
+	}
+	if n := len(w); n > 2 && w[n-1] == w[n-2] && !strings.ContainsRune("aeiou", rune(w[n-1])) {
+		w = w[:n-1]
+	}
+	return w
+}
+
+func stemAll(toks []string) []string {
+	out := make([]string, len(toks))
+	for i, t := range toks {
+		out[i] = stem(t)
+	}
+	return out
+}
+
+func phraseAt(toks []string, at int, phrase []string) bool {
+	if at+len(phrase) > len(toks) {
+		return false
+	}
+	for i, t := range phrase {
+		if toks[at+i] != t {
+			return false
+		}
+	}
+	return true
+}
+
+// actionAt reports whether an archetype phrase starts at toks[at].
+func actionAt(toks []string, at int) bool {
+	for _, p := range intentPhrases {
+		if phraseAt(toks, at, p.tokens) {
+			return p.archetype != ""
+		}
+	}
+	return false
+}
+
+// intentMatch is one archetype phrase found in the input.
+type intentMatch struct {
+	archetype string
+	start     int
+	end       int
+	lead      bool // first meaningful words of its clause
+	negated   bool
+}
+
+// ParseIntent resolves free text to an archetype, the carried items it mentions and the
+// target of its verb. Every verb found scores for its archetype (double when it leads its
+// clause); negated verbs don't score. Confidence is the winner's share of the score, reduced
+// when no verb leads.
+func ParseIntent(input string, inv Inventory) Intent {
+	raw := tokenize(input)
+	toks := stemAll(raw)
+	var matches []intentMatch
+	leadOpen, negate := true, false
+	for i := 0; i < len(toks); {
+		t := raw[i]
+		if t == "," || clauseBreaks[t] {
+			leadOpen, negate = true, false
+			i++
+			continue
+		}
+		if negators[t] {
+			negate = true
+			i++
+			continue
+		}
+		if adjacentNegators[t] && actionAt(toks, i+1) {
+			negate = true
+			i++
+			continue
+		}
+		if p, ok := negatorPhrase(raw, i); ok {
+			negate = true
+			i += p
+			continue
+		}
+		matched := false
+		for _, p := range intentPhrases {
+			if !phraseAt(toks, i, p.tokens) {
+				continue
+			}
+			if p.archetype != "" {
+				matches = append(matches, intentMatch{archetype: p.archetype, start: i, end: i + len(p.tokens), lead: leadOpen, negated: negate})
+				negate = false
+			}
+			leadOpen = false
+			i += len(p.tokens)
+			matched = true
+			break
+		}
+		if matched {
+			continue
+		}
+		if !intentFillers[t] {
+			leadOpen = false
+		}
+		i++
+	}
+	intent := Intent{Items: mentionedItems(toks, inv)}
+	scores := map[string]int{}
+	total := 0
+	for _, m := range matches {
+		if m.negated {
+			continue
+		}
+		w := 1
+		if m.lead {
+			w = 2
+		}
+		scores[m.archetype] += w
+		total += w
+	}
+	if total == 0 {
+		if len(matches) > 0 {
+			m := matches[0]
+			intent.Archetype, intent.Verb, intent.Negated = m.archetype, strings.Join(raw[m.start:m.end], " "), true
+		}
+		return intent
+	}
+	for _, a := range customMatchOrder {
+		if scores[a] > scores[intent.Archetype] {
+			intent.Archetype = a
+		}
+	}
+	lead := false
+	for _, m := range matches {
+		if m.archetype != intent.Archetype || m.negated {
+			continue
+		}
+		if intent.Verb == "" {
+			intent.Verb = strings.Join(raw[m.start:m.end], " ")
+			intent.Target = targetAfter(raw, m.end)
+		}
+		lead = lead || m.lead
+	}
+	intent.Confidence = float64(scores[intent.Archetype]) / float64(total)
+	if !lead {
+		intent.Confidence *= 0.75
+	}
+	return intent
+}
+
+func negatorPhrase(raw []string, at int) (int, bool) {
+	for _, p := range negatorPhrases {
+		if phraseAt(raw, at, p) {
+			return len(p), true
+		}
+	}
+	return 0, false
+}
+
+// targetAfter returns up to maxTargetWords following a verb, minus leading articles and
+// prepositions, stopping at the end of the clause.
+func targetAfter(raw []string, at int) string {
+	for at < len(raw) && targetSkips[raw[at]] {
+		at++
+	}
+	var words []string
+	for i := at; i < len(raw) && len(words) < maxTargetWords; i++ {
+		if raw[i] == "," || clauseBreaks[raw[i]] {
+			break
+		}
+		words = append(words, raw[i])
+	}
+	return strings.Join(words, " ")
+}
+
+// mentionedItems returns carried items named in the stemmed input, in inventory order.
+func mentionedItems(toks []string, inv Inventory) []string {
+	var out []string
+	for _, item := range carriedItems(inv) {
+		if contains(out, item) {
+			continue
+		}
+		phrase := stemAll(tokenize(item))
+		for i := range toks {
+			if len(phrase) > 0 && phraseAt(toks, i, phrase) {
+				out = append(out, item)
+				break
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,70 @@
+package engine
+
+import "testing"
+
+func TestParseIntentResolvesVerbsAndSynonyms(t *testing.T) {
+	cases := map[string]string{
+		"I rest my hopes on finding water":   "forage",
+		"craft a spear from the broken pipe": "craft",
+		"keep watch from the rooftop":        "observe",
+		"try to persuade the guards":         "diplomacy",
+		"we should go scout the ridge":       "scout",
+		"Searching the lockers":              "forage",
+		"patch up my leg":                    "medicate",
+		"patch the roof":                     "repair",
+		"I go to sleep":                      "rest",
+	}
+	for input, want := range cases {
+		if got := ParseIntent(input, Inventory{}); got.Archetype != want {
+			t.Fatalf("%q: expected %s, got %+v", input, want, got)
+		}
+	}
+}
+
+func TestParseIntentExtractsItemsAndTarget(t *testing.T) {
+	inv := Inventory{Tools: []string{"crowbar"}, Medical: []string{"antibiotics"}}
+	got := ParseIntent("Use the crowbar to fortify the north gate", inv)
+	if got.Archetype != "barricade" || got.Target != "north gate" {
+		t.Fatalf("unexpected intent: %+v", got)
+	}
+	if len(got.Items) != 1 || got.Items[0] != "crowbar" {
+		t.Fatalf("expected crowbar referenced, got %v", got.Items)
+	}
+	if got := ParseIntent("take an antibiotic", inv); !contains(got.Items, "antibiotics") {
+		t.Fatalf("expected singular mention to find antibiotics, got %v", got.Items)
+	}
+}
+
+func TestParseIntentNegationAndConfidence(t *testing.T) {
+	if got := ParseIntent("don't fight them", Inventory{}); !got.Negated || got.Archetype != "fight" {
+		t.Fatalf("expected negated fight, got %+v", got)
+	}
+	if got := ParseIntent("don't fight, hide in the shed", Inventory{}); got.Negated || got.Archetype != "hide" {
+		t.Fatalf("expected hide to win over a negated fight, got %+v", got)
+	}
+	if got := ParseIntent("stop fighting", Inventory{}); !got.Negated || got.Archetype != "fight" {
+		t.Fatalf("expected stop to negate the verb after it, got %+v", got)
+	}
+	if got := ParseIntent("stop the bleeding with a bandage", Inventory{}); got.Negated || got.Archetype != "medicate" {
+		t.Fatalf("expected stop the bleeding to treat, not negate, got %+v", got)
+	}
+	clear := ParseIntent("scout the ridge", Inventory{})
+	muddled := ParseIntent("scout, rest, forage or trade", Inventory{})
+	if clear.Confidence != 1 || muddled.Confidence >= minIntentConfidence {
+		t.Fatalf("expected clear=1 and muddled below threshold, got %.2f / %.2f", clear.Confidence, muddled.Confidence)
+	}
+}
+
+func TestCustomActionRefusesNegatedOrAmbiguous(t *testing.T) {
+	s := Survivor{Stats: Stats{Health: 100}}
+	if _, ok, reason := ValidateCustomAction("never rest", s, 0, DifficultyStandard); ok || reason == "" {
+		t.Fatalf("expected negated action refused with a reason")
+	}
+	if _, ok, _ := ValidateCustomAction("scout, rest, forage or trade", s, 0, DifficultyStandard); ok {
+		t.Fatalf("expected ambiguous action refused")
+	}
+	c, ok, _ := ValidateCustomAction("barter the crowbar for food", Survivor{Inventory: Inventory{Tools: []string{"crowbar"}}}, 0, DifficultyStandard)
+	if !ok || len(c.Effects.LoseItems) != 1 || c.Effects.LoseItems[0] != "crowbar" {
+		t.Fatalf("expected trade to give up the named item, got %+v", c.Effects)
+	}
+}
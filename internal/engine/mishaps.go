@@ -0,0 +1,138 @@
+package engine
+
+// mishap describes a setback that can materialize when a risky choice goes wrong.
+type mishap struct {
+	ID         string
+	Condition  Condition // optional condition applied when the mishap lands
+	Delta      Stats     // immediate stat shock
+	LoseItem   bool      // drop a random carried item
+	MinRisk    RiskLevel // lowest risk tier that can roll this mishap
+	Weight     int
+	Categories []string // archetype categories this mishap applies to; empty = any
+}
+
+var mishapTable = []mishap{
+	{ID: "bleeding", Condition: ConditionBleeding, Delta: Stats{Health: -4}, MinRisk: RiskModerate, Weight: 4, Categories: []string{"physical"}},
+	{ID: "sprain", Condition: ConditionSprain, Delta: Stats{Fatigue: 4}, MinRisk: RiskLow, Weight: 5, Categories: []string{"physical"}},
+	{ID: "fracture", Condition: ConditionFracture, Delta: Stats{Health: -8, Morale: -3}, MinRisk: RiskHigh, Weight: 2, Categories: []string{"physical"}},
+	{ID: "lost_items", LoseItem: true, Delta: Stats{Morale: -2}, MinRisk: RiskLow, Weight: 4},
+	{ID: "morale_shock", Delta: Stats{Morale: -8}, MinRisk: RiskLow, Weight: 5},
+	{ID: "shellshock", Condition: ConditionShellshock, Delta: Stats{Morale: -12}, MinRisk: RiskHigh, Weight: 1},
+}
+
+// mishapResult reports what a risk roll produced.
+type mishapResult struct {
+	ID        string
+	Delta     Stats
+	Added     []Condition
+	LostItems []string
+}
+
+// mishapChance returns the percentage chance that a choice's risk materializes.
+func mishapChance(risk RiskLevel, diff Difficulty, skillLevel int) int {
+	chance := 0
+	switch risk {
+	case RiskLow:
+		chance = 6
+	case RiskModerate:
+		chance = 18
+	default:
+		chance = 35
+	}
+	switch diff {
+	case DifficultyEasy:
+		chance = chance * 6 / 10
+	case DifficultyHard:
+		chance = chance * 14 / 10
+	}
+	chance -= skillLevel * 3
+	if chance < 1 {
+		chance = 1
+	}
+	return chance
+}
+
+// materializeRisk rolls the mishap table for a resolved choice and applies the result to the survivor.
+func materializeRisk(s *Survivor, c Choice, diff Difficulty, stream *Stream) mishapResult {
+	res := mishapResult{}
+	if s == nil || stream == nil || c.Archetype == "" {
+		return res
+	}
+	skill := s.Skills[relevantSkill(c.Archetype)]
+	if stream.Child("roll").Intn(100) >= mishapChance(c.Risk, diff, skill) {
+		return res
+	}
+	cat := archetypeCategory(c.Archetype)
+	var pool []mishap
+	total := 0
+	for _, m := range mishapTable {
+		if riskScore(c.Risk) < riskScore(m.MinRisk) {
+			continue
+		}
+		if len(m.Categories) > 0 && !contains(m.Categories, cat) {
+			continue
+		}
+		if m.LoseItem && len(carriedItems(s.Inventory)) == 0 {
+			continue
+		}
+		pool = append(pool, m)
+		total += m.Weight
+	}
+	if total == 0 {
+		return res
+	}
+	pick := stream.Child("table").Intn(total)
+	var chosen mishap
+	for _, m := range pool {
+		if pick < m.Weight {
+			chosen = m
+			break
+		}
+		pick -= m.Weight
+	}
+	res.ID = chosen.ID
+	res.Delta = chosen.Delta
+	if chosen.Condition != "" && addConditionIfAbsent(s, chosen.Condition) {
+		res.Added = append(res.Added, chosen.Condition)
+	}
+	if chosen.LoseItem {
+		if item, ok := loseRandomItem(&s.Inventory, stream.Child("item")); ok {
+			res.LostItems = append(res.LostItems, item)
+		}
+	}
+	return res
+}
+
+func carriedItems(inv Inventory) []string {
+	var items []string
+	items = append(items, inv.Weapons...)
+	items = append(items, inv.Medical...)
+	items = append(items, inv.Tools...)
+	items = append(items, inv.Special...)
+	return items
+}
+
+// loseRandomItem removes one carried item (mementos are never lost).
+func loseRandomItem(inv *Inventory, stream *Stream) (string, bool) {
+	items := carriedItems(*inv)
+	if len(items) == 0 {
+		return "", false
+	}
+	item := items[stream.Intn(len(items))]
+	for _, list := range []*[]string{&inv.Weapons, &inv.Medical, &inv.Tools, &inv.Special} {
+		if removeString(list, item) {
+			return item, true
+		}
+	}
+	return "", false
+}
+
+func removeString(list *[]string, v string) bool {
+	for i, x := range *list {
+		if x == v {
+			*list = append((*list)[:i], (*list)[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package engine
+
+import (
+	"fmt"
+	"testing"
+)
+
+func countMishaps(risk RiskLevel, skill int) int {
+	seed, _ := NewRunSeed("mishap-seed")
+	hits := 0
+	for i := 0; i < 200; i++ {
+		s := Survivor{Stats: Stats{Health: 100, Morale: 60}, Skills: map[Skill]int{SkillScavenging: skill}, Meters: baselineMeters(), Inventory: baseInventory(nil)}
+		c := Choice{ID: "m", Archetype: "forage", Risk: risk, Outcome: ChoiceOutcome{}}
+		res := ApplyChoice(&s, c, DifficultyStandard, i, seed.Stream(fmt.Sprintf("turn:%d", i)))
+		if res.Mishap != "" {
+			hits++
+		}
+	}
+	return hits
+}
+
+func TestMishapsScaleWithRisk(t *testing.T) {
+	low := countMishaps(RiskLow, 0)
+	high := countMishaps(RiskHigh, 0)
+	if high <= low {
+		t.Fatalf("expected high risk to produce more mishaps than low (low=%d high=%d)", low, high)
+	}
+	if skilled := countMishaps(RiskHigh, 5); skilled >= high {
+		t.Fatalf("expected skill to reduce mishaps (unskilled=%d skilled=%d)", high, skilled)
+	}
+}
+
+func TestMishapReportsConditionsAndItems(t *testing.T) {
+	seed, _ := NewRunSeed("mishap-report")
+	for i := 0; i < 500; i++ {
+		s := Survivor{Stats: Stats{Health: 100, Morale: 60}, Skills: map[Skill]int{}, Meters: baselineMeters(), Inventory: baseInventory(nil)}
+		res := materializeRisk(&s, Choice{Archetype: "barricade", Risk: RiskHigh}, DifficultyHard, seed.Stream(fmt.Sprintf("r:%d", i)))
+		switch res.ID {
+		case "bleeding", "sprain", "fracture":
+			if len(res.Added) != 1 || !survivorHasCondition(s, res.Added[0]) {
+				t.Fatalf("mishap %s should add its condition, got %+v", res.ID, res.Added)
+			}
+		case "lost_items":
+			if len(res.LostItems) != 1 {
+				t.Fatalf("lost_items mishap should report the lost item")
+			}
+		}
+	}
+}
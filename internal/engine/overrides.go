@@ -0,0 +1,110 @@
+package engine
+
+import "fmt"
+
+// ChoiceOverride tunes one archetype for a specific event. Outcome ranges replace the
+// profile's range for the same stat, Effects are merged onto the profile's base effects and
+// RiskShift moves the planned risk up or down before difficulty and traits apply.
+type ChoiceOverride struct {
+	Outcome   ChoiceOutcome `yaml:"outcome"`
+	Effects   ChoiceEffect  `yaml:"effects"`
+	RiskShift int           `yaml:"risk_shift"`
+}
+
+// apply returns a copy of base with the override's ranges swapped in.
+func (o ChoiceOverride) apply(base ChoiceOutcome) ChoiceOutcome {
+	out := cloneOutcome(base)
+	if len(o.Outcome) == 0 {
+		return out
+	}
+	if out == nil {
+		out = make(ChoiceOutcome, len(o.Outcome))
+	}
+	for k, rng := range o.Outcome {
+		out[k] = rng
+	}
+	return out
+}
+
+func (o ChoiceOverride) validate() error {
+	for k, rng := range o.Outcome {
+		if !validStatKey(k) {
+			return fmt.Errorf("unknown outcome stat %q", k)
+		}
+		if rng.Min > rng.Max {
+			return fmt.Errorf("outcome %s: min above max", k)
+		}
+	}
+	for _, c := range append(append([]Condition{}, o.Effects.AddConditions...), o.Effects.RemoveConditions...) {
+		if !c.Validate() {
+			return fmt.Errorf("unknown condition %q", c)
+		}
+	}
+	for m := range o.Effects.MeterDeltas {
+		if !m.Validate() {
+			return fmt.Errorf("unknown meter %q", m)
+		}
+	}
+	for c, chance := range o.Effects.Hazards {
+		if !c.Validate() {
+			return fmt.Errorf("unknown hazard condition %q", c)
+		}
+		if chance < 1 || chance > 100 {
+			return fmt.Errorf("hazard %s: chance must be 1-100", c)
+		}
+	}
+	if o.RiskShift < -2 || o.RiskShift > 2 {
+		return fmt.Errorf("risk_shift must be between -2 and 2")
+	}
+	return nil
+}
+
+// rollHazards lands each hazard on its percentage roll, in condition order so replays match.
+func rollHazards(s *Survivor, hazards map[Condition]int, stream *Stream) []Condition {
+	if s == nil || len(hazards) == 0 || stream == nil {
+		return nil
+	}
+	var added []Condition
+	for _, cond := range AllConditions {
+		chance, ok := hazards[cond]
+		if !ok {
+			continue
+		}
+		if stream.Intn(100) < chance && addConditionIfAbsent(s, cond) {
+			added = append(added, cond)
+		}
+	}
+	return added
+}
+
+// gainItems stores found items; anything in the treatment table goes with the medical supplies.
+func gainItems(inv *Inventory, items []string) []string {
+	if inv == nil || len(items) == 0 {
+		return nil
+	}
+	for _, item := range items {
+		if _, ok := findTreatment(item); ok {
+			inv.Medical = append(inv.Medical, item)
+		} else {
+			inv.Special = append(inv.Special, item)
+		}
+	}
+	return append([]string{}, items...)
+}
+
+// loseItems removes items the choice spends or gives away; items not carried are skipped.
+func loseItems(inv *Inventory, items []string) []string {
+	if inv == nil {
+		return nil
+	}
+	var lost []string
+	for _, item := range items {
+		for _, list := range []*[]string{&inv.Weapons, &inv.Medical, &inv.Tools, &inv.Special} {
+			if removeString(list, item) {
+				lost = append(lost, item)
+				break
+			}
+		}
+	}
+	return lost
+}
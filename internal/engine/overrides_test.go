@@ -0,0 +1,76 @@
+package engine
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestBlueprintOverrideShapesChoice(t *testing.T) {
+	bp := catalogByID()["neighborhood_breach"]
+	c, err := buildChoiceFromPlan(bp, 0, PlannedChoice{Label: "Hold the door", Archetype: "barricade", Risk: "low"})
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	if c.Risk != RiskModerate {
+		t.Fatalf("expected breach barricade risk shifted to moderate, got %s", c.Risk)
+	}
+	if c.Effects.Hazards[ConditionBleeding] != 30 {
+		t.Fatalf("expected bleeding hazard from override, got %+v", c.Effects.Hazards)
+	}
+	plain, _ := buildChoiceFromPlan(catalogByID()["quiet_hour"], 0, PlannedChoice{Label: "Hold the door", Archetype: "barricade", Risk: "low"})
+	if plain.Risk != RiskLow || len(plain.Effects.Hazards) != 0 || !reflect.DeepEqual(plain.Effects.MeterDeltas, archetypeProfiles["barricade"].BaseEffects.MeterDeltas) {
+		t.Fatalf("events without an override should keep the generic profile: %+v", plain)
+	}
+}
+
+func TestOverrideOutcomeReplacesProfileRange(t *testing.T) {
+	bp := catalogByID()["makeshift_clinic"]
+	c, err := buildChoiceFromPlan(bp, 1, PlannedChoice{Label: "Patch up", Archetype: "medicate"})
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	if c.Outcome[StatHealth] != (DeltaRange{Min: 2, Max: 4}) {
+		t.Fatalf("expected clinic health range, got %+v", c.Outcome[StatHealth])
+	}
+	if c.Outcome[StatFatigue] != archetypeProfiles["medicate"].BaseOutcome[StatFatigue] {
+		t.Fatalf("expected untouched stats to keep the profile range")
+	}
+	if _, ok := archetypeProfiles["medicate"].BaseOutcome[StatHealth]; ok {
+		t.Fatalf("override leaked into the shared profile")
+	}
+}
+
+func TestApplyChoiceGrantsItemsAndRollsHazards(t *testing.T) {
+	seed, _ := NewRunSeed("overrides")
+	bleeds := 0
+	for i := 0; i < 40; i++ {
+		s := Survivor{Stats: Stats{Health: 100, Morale: 60}, Skills: map[Skill]int{}, Meters: baselineMeters()}
+		c := Choice{ID: "h", Archetype: "forage", Risk: RiskLow, Effects: ChoiceEffect{
+			GainItems: []string{"antibiotics", "road flare"},
+			Hazards:   map[Condition]int{ConditionBleeding: 50},
+		}}
+		res := ApplyChoice(&s, c, DifficultyStandard, i, seed.Stream(fmt.Sprintf("turn:%d", i)))
+		if len(res.Gained) != 2 || !contains(s.Inventory.Medical, "antibiotics") || !contains(s.Inventory.Special, "road flare") {
+			t.Fatalf("expected items sorted into medical and special, got %+v", s.Inventory)
+		}
+		if survivorHasCondition(s, ConditionBleeding) {
+			bleeds++
+		}
+	}
+	if bleeds == 0 || bleeds == 40 {
+		t.Fatalf("expected a 50%% hazard to land sometimes, landed %d/40", bleeds)
+	}
+}
+
+func TestValidateRejectsBadOverride(t *testing.T) {
+	bad := []EventBlueprint{{ID: "x", Name: "X", Tier: "any", Scale: "minor", Weight: 1,
+		Choices: map[string]ChoiceOverride{"barricade": {Effects: ChoiceEffect{Hazards: map[Condition]int{ConditionBleeding: 150}}}}}}
+	if err := validateCatalog(bad); err == nil {
+		t.Fatalf("expected out-of-range hazard chance to be rejected")
+	}
+	bad[0].Choices = map[string]ChoiceOverride{"juggle": {}}
+	if err := validateCatalog(bad); err == nil {
+		t.Fatalf("expected unknown archetype override to be rejected")
+	}
+}
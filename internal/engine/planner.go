@@ -0,0 +1,228 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// LocalDirector is an offline DirectorPlanner. It picks events from the request's available
+// blueprints by weight and assembles choices from archetypeProfiles, so scenes can be planned
+// without an AI provider. Plans are deterministic for a given stream and request.
+type LocalDirector struct {
+	stream *Stream
+}
+
+// NewLocalDirector returns an offline planner drawing from the run stream.
+func NewLocalDirector(stream *Stream) *LocalDirector {
+	return &LocalDirector{stream: stream}
+}
+
+const (
+	minPlannedChoices = 2
+	maxPlannedChoices = 6
+)
+
+// choiceLabels holds stock labels per archetype for offline plans.
+var choiceLabels = map[string][]string{
+	"rest":      {"Rest and recover", "Sleep in shifts", "Lie low until you have your strength back"},
+	"forage":    {"Search nearby for supplies", "Scavenge what you can carry", "Check abandoned stores for food and water"},
+	"scout":     {"Scout the surrounding streets", "Climb high and survey the area", "Recon the route ahead"},
+	"organize":  {"Sort and repack supplies", "Take stock and plan the next day", "Organize the shelter"},
+	"barricade": {"Reinforce the entrances", "Board up the weak points", "Secure the perimeter"},
+	"craft":     {"Improvise gear from scraps", "Repair worn equipment", "Rig a makeshift tool"},
+	"diplomacy": {"Talk to the others nearby", "Negotiate for safe passage", "Reason with the strangers"},
+	"observe":   {"Watch and wait", "Keep a quiet lookout", "Study the movement outside"},
+	"medicate":  {"Treat your injuries", "Patch yourself up", "Tend to your wounds"},
+	"travel":    {"Push on toward the next district", "Move out before dark", "Take the back roads onward"},
+	"trade":     {"Barter with the other survivors", "Swap spare gear for food", "Trade for what you need"},
+	"fight":     {"Fight your way through", "Take them down quietly", "Stand your ground"},
+	"hide":      {"Hide and let it pass", "Take cover and stay silent", "Go to ground"},
+	"hunt":      {"Hunt for fresh meat", "Set snares along the treeline", "Track game nearby"},
+	"repair":    {"Repair the shelter", "Fix the broken gear", "Patch up the defences"},
+	"signal":    {"Send a signal", "Light a beacon", "Try the radio bands"},
+}
+
+// PlanEvent selects a weighted event and scaffolds 2-6 choices for it.
+func (d *LocalDirector) PlanEvent(ctx context.Context, req DirectorRequest) (DirectorPlan, error) {
+	if err := ctx.Err(); err != nil {
+		return DirectorPlan{}, err
+	}
+	if len(req.Available) == 0 {
+		return DirectorPlan{}, errors.New("no available events")
+	}
+	stream := d.stream
+	if stream == nil {
+		stream = newStream(SeedFromString("local-director"))
+	}
+	stream = stream.Child(fmt.Sprintf("scene:%d:last:%s", req.SceneIndex, req.History.LastEvent))
+	bp := pickWeightedEvent(req.Available, req.History, stream.Child("event"))
+	choices := planChoices(req, bp, stream.Child("choices"))
+	return DirectorPlan{
+		EventID:   bp.ID,
+		EventName: bp.Name,
+		Guidance:  fmt.Sprintf("%s (%s event)", bp.Name, bp.Scale),
+		Choices:   choices,
+	}, nil
+}
+
+// eventPickWeight scales a blueprint's Weight by recency and pacing. Events seen recently are
+// damped (most recent hardest), arc follow-ups are favoured, a major event straight after
+// another major one is halved, and majors are favoured when none appear in the recent window.
+func eventPickWeight(bp EventBlueprint, history HistorySnapshot, lastScale string) int {
+	w := bp.Weight * 10
+	if w <= 0 {
+		w = 1
+	}
+	for i, id := range history.Recent {
+		if id == bp.ID {
+			w = w * (i + 1) / (len(history.Recent) + 1)
+			break
+		}
+	}
+	if bp.ArcStep > 1 {
+		// follow-up steps are only offered while their arc is live; keep the thread going
+		w *= 2
+	}
+	if bp.Scale == "major" {
+		switch lastScale {
+		case "major":
+			w /= 2
+		case "":
+			// no recent major events: build toward one
+			w = w * 3 / 2
+		}
+	}
+	if w < 1 {
+		w = 1
+	}
+	return w
+}
+
+// pickWeightedEvent draws an event by eventPickWeight.
+func pickWeightedEvent(available []EventBlueprint, history HistorySnapshot, stream *Stream) EventBlueprint {
+	catalog := catalogByID()
+	lastScale := ""
+	if prev, ok := catalog[history.LastEvent]; ok && prev.Scale == "major" {
+		lastScale = "major"
+	} else {
+		for _, id := range history.Recent {
+			if catalog[id].Scale == "major" {
+				lastScale = "recent"
+				break
+			}
+		}
+	}
+	total := 0
+	weights := make([]int, len(available))
+	for i, bp := range available {
+		weights[i] = eventPickWeight(bp, history, lastScale)
+		total += weights[i]
+	}
+	pick := stream.Intn(total)
+	for i, w := range weights {
+		if pick < w {
+			return available[i]
+		}
+		pick -= w
+	}
+	return available[len(available)-1]
+}
+
+// canMedicate reports whether the survivor in a narrative state carries an item they have the
+// skill to use on one of their conditions, the same test custom medicate actions must pass.
+func canMedicate(state map[string]any) bool {
+	var s Survivor
+	s.Conditions, _ = state["conditions"].([]Condition)
+	s.Skills, _ = state["skills"].(map[Skill]int)
+	s.Inventory, _ = state["inventory"].(Inventory)
+	_, ok := bestTreatment(s)
+	return ok
+}
+
+// planChoices puts the survivor's pressing needs first, then fills with random archetypes.
+// Scarcity makes hunger and thirst pressing sooner and adds a hunt when stock runs low.
+func planChoices(req DirectorRequest, bp EventBlueprint, stream *Stream) []PlannedChoice {
+	stats, _ := req.State["stats"].(Stats)
+	treatable := canMedicate(req.State)
+	var picked []string
+	add := func(a string) {
+		if _, ok := archetypeProfiles[a]; ok && !contains(picked, a) {
+			picked = append(picked, a)
+		}
+	}
+	if treatable {
+		add("medicate")
+	}
+	econ := economyFor(req.Scarcity)
+	meters, _ := req.State["meters"].(map[Meter]int)
+	if stats.Hunger >= econ.NeedsUrgency || stats.Thirst >= econ.NeedsUrgency {
+		add("forage")
+	}
+	if req.Scarcity && meters != nil && meters[MeterSupplyBuffer] < 20 {
+		// under two days of stock: keep a second way of bringing food in on the table
+		add("hunt")
+	}
+	if stats.Fatigue >= 60 {
+		add("rest")
+	}
+	count := 3 + stream.Child("count").Intn(2)
+	if bp.Scale == "major" {
+		count++
+	}
+	if count > maxPlannedChoices {
+		count = maxPlannedChoices
+	}
+	pool := AllowedArchetypes()
+	shuffle := stream.Child("pool")
+	for len(picked) < count && len(pool) > 0 {
+		idx := shuffle.Intn(len(pool))
+		a := pool[idx]
+		pool = append(pool[:idx], pool[idx+1:]...)
+		if a == "medicate" && !treatable {
+			continue
+		}
+		add(a)
+	}
+	if len(picked) > count {
+		picked = picked[:count]
+	}
+	out := make([]PlannedChoice, 0, len(picked))
+	for _, a := range picked {
+		profile := archetypeProfiles[a]
+		out = append(out, PlannedChoice{
+			Label:     plannedLabel(a, stream.Child("label:"+a)),
+			Archetype: a,
+			Cost: PlanCost{
+				Time:    profile.BaseCost.Time,
+				Fatigue: profile.BaseCost.Fatigue,
+				Hunger:  profile.BaseCost.Hunger,
+				Thirst:  profile.BaseCost.Thirst,
+			},
+			Risk: strings.ToLower(string(plannedRisk(a, bp, req.InfectedLocal))),
+		})
+	}
+	return out
+}
+
+// plannedRisk starts physical work at moderate once infected are present; major events add a tier.
+// Scarcity's supply risk is left to adjustRisk so it applies to every planner alike.
+func plannedRisk(archetype string, bp EventBlueprint, infected bool) RiskLevel {
+	score := 0
+	if infected && archetypeCategory(archetype) == "physical" {
+		score++
+	}
+	if bp.Scale == "major" && archetypeCategory(archetype) != "rest" {
+		score++
+	}
+	return riskFromScore(score)
+}
+
+func plannedLabel(archetype string, stream *Stream) string {
+	labels := choiceLabels[archetype]
+	if len(labels) == 0 {
+		return strings.ToUpper(archetype[:1]) + archetype[1:]
+	}
+	return labels[stream.Intn(len(labels))]
+}
@@ -0,0 +1,96 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestLocalDirectorPlansOffline(t *testing.T) {
+	seed, _ := NewRunSeed("local-director")
+	survivor := testSurvivor("local-director")
+	director := NewLocalDirector(seed.Stream("director"))
+	for scene := 0; scene < 20; scene++ {
+		choices, ctx, err := GenerateChoices(context.Background(), director, seed.Stream("choices"), &survivor, EventHistory{}, scene)
+		if err != nil {
+			t.Fatalf("scene %d: offline director failed: %v", scene, err)
+		}
+		if len(choices) < minPlannedChoices || len(choices) > maxPlannedChoices {
+			t.Fatalf("scene %d: expected 2-6 choices, got %d", scene, len(choices))
+		}
+		if ctx.Event.ID == "" {
+			t.Fatalf("scene %d: expected an event", scene)
+		}
+	}
+}
+
+func TestLocalDirectorDeterministic(t *testing.T) {
+	seed, _ := NewRunSeed("local-director-det")
+	survivor := testSurvivor("local-director-det")
+	req := DirectorRequest{State: survivor.NarrativeState(), Available: availableEventBlueprints(&survivor, EventHistory{}, 3), SceneIndex: 3}
+	a, _ := NewLocalDirector(seed.Stream("director")).PlanEvent(context.Background(), req)
+	b, _ := NewLocalDirector(seed.Stream("director")).PlanEvent(context.Background(), req)
+	if !reflect.DeepEqual(a, b) {
+		t.Fatalf("expected identical plans for the same stream and request:\n%+v\n%+v", a, b)
+	}
+}
+
+func TestLocalDirectorHonoursWeightAndHistory(t *testing.T) {
+	heavy := EventBlueprint{ID: "heavy", Name: "Heavy", Scale: "minor", Weight: 9}
+	light := EventBlueprint{ID: "light", Name: "Light", Scale: "minor", Weight: 1}
+	seed, _ := NewRunSeed("weights")
+	heavyPicks := 0
+	for i := 0; i < 200; i++ {
+		if pickWeightedEvent([]EventBlueprint{heavy, light}, HistorySnapshot{}, seed.Stream(fmt.Sprintf("p:%d", i))).ID == "heavy" {
+			heavyPicks++
+		}
+	}
+	if heavyPicks < 150 {
+		t.Fatalf("expected heavy event to dominate, picked %d/200", heavyPicks)
+	}
+	recent := HistorySnapshot{LastEvent: "heavy", Recent: []string{"heavy"}}
+	if eventPickWeight(heavy, recent, "") >= eventPickWeight(heavy, HistorySnapshot{}, "") {
+		t.Fatalf("expected the most recent event to be damped")
+	}
+}
+
+func TestLocalDirectorPrioritisesNeeds(t *testing.T) {
+	seed, _ := NewRunSeed("needs")
+	req := DirectorRequest{
+		State: map[string]any{
+			"stats":      Stats{Hunger: 80, Fatigue: 75},
+			"conditions": []Condition{ConditionBleeding},
+			"skills":     map[Skill]int{},
+			"inventory":  Inventory{Medical: []string{"bandage"}},
+		},
+		Available: []EventBlueprint{{ID: "quiet_hour", Name: "Uneasy Quiet Hour", Scale: "minor", Weight: 6}},
+	}
+	plan, err := NewLocalDirector(seed.Stream("d")).PlanEvent(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]bool{}
+	for _, c := range plan.Choices {
+		got[c.Archetype] = true
+	}
+	for _, want := range []string{"medicate", "forage", "rest"} {
+		if !got[want] {
+			t.Fatalf("expected %s offered for a hungry, tired, bleeding survivor: %+v", want, plan.Choices)
+		}
+	}
+
+	// a fracture needs a splint or trauma kit, neither of which the survivor carries
+	req.State["conditions"] = []Condition{ConditionFracture}
+	for i := 0; i < 20; i++ {
+		plan, err := NewLocalDirector(seed.Stream(fmt.Sprintf("untreatable:%d", i))).PlanEvent(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, c := range plan.Choices {
+			if c.Archetype == "medicate" {
+				t.Fatalf("expected no medicate without a usable item: %+v", plan.Choices)
+			}
+		}
+	}
+}
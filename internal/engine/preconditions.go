@@ -0,0 +1,57 @@
+package engine
+
+// EventRequirements are eligibility predicates a blueprint declares over the survivor and
+// their environment. Empty fields place no constraint.
+type EventRequirements struct {
+	Locations       []LocationType `json:"locations,omitempty" yaml:"locations,omitempty"`
+	Seasons         []Season       `json:"seasons,omitempty" yaml:"seasons,omitempty"`
+	Weather         []Weather      `json:"weather,omitempty" yaml:"weather,omitempty"`
+	MinSkills       map[Skill]int  `json:"min_skills,omitempty" yaml:"min_skills,omitempty"`
+	Items           []string       `json:"items,omitempty" yaml:"items,omitempty"` // every listed item must be carried
+	Groups          []GroupType    `json:"groups,omitempty" yaml:"groups,omitempty"`
+	MinDaysSinceLAD int            `json:"min_days_since_lad,omitempty" yaml:"min_days_since_lad,omitempty"`
+	MaxDaysSinceLAD int            `json:"max_days_since_lad,omitempty" yaml:"max_days_since_lad,omitempty"` // 0 = unbounded
+}
+
+// met reports whether the survivor satisfies every declared requirement.
+func (r EventRequirements) met(s *Survivor) bool {
+	if s == nil {
+		return false
+	}
+	env := s.Environment
+	loc := env.Location
+	if loc == "" {
+		loc = s.Location
+	}
+	if len(r.Locations) > 0 && !contains(r.Locations, loc) {
+		return false
+	}
+	if len(r.Seasons) > 0 && !contains(r.Seasons, env.Season) {
+		return false
+	}
+	if len(r.Weather) > 0 && !contains(r.Weather, env.Weather) {
+		return false
+	}
+	for sk, lvl := range r.MinSkills {
+		if s.Skills[sk] < lvl {
+			return false
+		}
+	}
+	carried := carriedItems(s.Inventory)
+	for _, item := range r.Items {
+		if !contains(carried, item) {
+			return false
+		}
+	}
+	if len(r.Groups) > 0 && !contains(r.Groups, s.Group) {
+		return false
+	}
+	days := env.WorldDay - env.LAD
+	if r.MinDaysSinceLAD > 0 && days < r.MinDaysSinceLAD {
+		return false
+	}
+	if r.MaxDaysSinceLAD > 0 && days > r.MaxDaysSinceLAD {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,58 @@
+package engine
+
+import "testing"
+
+func TestEventRequirementsFilterCandidates(t *testing.T) {
+	survivor := testSurvivor("preconditions")
+	survivor.Environment.WorldDay = 400
+	survivor.Environment.LAD = 0
+	survivor.updateInfectionPresence()
+	survivor.Location = LocationDesert
+	survivor.Environment.Location = LocationDesert
+
+	events := availableEventBlueprints(&survivor, EventHistory{}, 0)
+	for _, ev := range events {
+		if ev.ID == "rooftop_signal" || ev.Name == "Harbor Exodus Finale at Obsidian Breakwater" {
+			t.Fatalf("%q should not be offered in a desert", ev.Name)
+		}
+	}
+	if !hasEvent(events, "adapted_stalkers") {
+		t.Fatalf("expected long post-arrival events to be eligible on day 400")
+	}
+
+	survivor.Location = LocationHarbor
+	survivor.Environment.Location = LocationHarbor
+	events = availableEventBlueprints(&survivor, EventHistory{}, 0)
+	if !hasEvent(events, slugify("Harbor Exodus Finale Obsidian Breakwater post_arrival")) {
+		t.Fatalf("expected harbor finale to be eligible at a harbor")
+	}
+}
+
+func TestEventRequirementsPredicates(t *testing.T) {
+	s := &Survivor{
+		Group:       GroupSolo,
+		Skills:      map[Skill]int{SkillMedicine: 1},
+		Inventory:   Inventory{Tools: []string{"pocket knife"}},
+		Environment: Environment{Location: LocationForest, Season: SeasonWinter, Weather: WeatherSnow, WorldDay: 10, LAD: 5},
+	}
+	cases := []struct {
+		name string
+		req  EventRequirements
+		want bool
+	}{
+		{"empty", EventRequirements{}, true},
+		{"season", EventRequirements{Seasons: []Season{SeasonSummer}}, false},
+		{"weather", EventRequirements{Weather: []Weather{WeatherSnow}}, true},
+		{"skill", EventRequirements{MinSkills: map[Skill]int{SkillMedicine: 2}}, false},
+		{"item", EventRequirements{Items: []string{"pocket knife"}}, true},
+		{"missing item", EventRequirements{Items: []string{"weather radio"}}, false},
+		{"group", EventRequirements{Groups: []GroupType{GroupDuo}}, false},
+		{"days", EventRequirements{MinDaysSinceLAD: 3, MaxDaysSinceLAD: 10}, true},
+		{"too early", EventRequirements{MinDaysSinceLAD: 30}, false},
+	}
+	for _, tc := range cases {
+		if got := tc.req.met(s); got != tc.want {
+			t.Fatalf("%s: met=%v want %v", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,324 @@
+package engine
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// RouteMode is the kind of link between two regions.
+type RouteMode string
+
+const (
+	RouteAir  RouteMode = "air"
+	RouteRail RouteMode = "rail"
+	RouteRoad RouteMode = "road"
+	RouteSea  RouteMode = "sea"
+)
+
+// RegionNode is one region of the run's world map. LAD is fixed when the graph is built so
+// every survivor placed in the region shares it.
+type RegionNode struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"` // coarse label shown in the UI
+	Lat         float64 `json:"lat"`
+	Lon         float64 `json:"lon"`
+	Hub         bool    `json:"hub"`   // major airport / high-speed rail hub
+	Rural       bool    `json:"rural"` // mostly rural; slower spread
+	Closures    bool    `json:"closures"`
+	Evac        bool    `json:"evac"`
+	SpreadKM    float64 `json:"spread_km"` // shortest-path spread distance from the origin region
+	OriginKM    float64 `json:"origin_km"` // great-circle distance to the origin region
+	LAD         int     `json:"lad"`
+	Unreachable bool    `json:"unreachable,omitempty"`
+}
+
+// RegionEdge links two regions. Edges are undirected; Closed edges carry no traffic this run.
+type RegionEdge struct {
+	From   string    `json:"from"`
+	To     string    `json:"to"`
+	Mode   RouteMode `json:"mode"`
+	KM     float64   `json:"km"`
+	Closed bool      `json:"closed,omitempty"`
+}
+
+// RegionGraph is the per-run world map the outbreak spreads across.
+type RegionGraph struct {
+	Origin string       `json:"origin"` // id of the region holding the origin site
+	Nodes  []RegionNode `json:"nodes"`
+	Edges  []RegionEdge `json:"edges"`
+}
+
+// routeCost converts a link's length into spread distance: fast modes shrink the world, while
+// air and sea pay a fixed overhead for screening and port handling.
+var routeCost = map[RouteMode]struct{ Factor, Overhead float64 }{
+	RouteAir:  {0.15, 400},
+	RouteRail: {0.6, 0},
+	RouteRoad: {1.0, 0},
+	RouteSea:  {0.8, 200},
+}
+
+const (
+	airClosureChance = 15 // percent of flight links grounded per run
+	closuresChance   = 15 // percent of regions that close their borders
+	evacChance       = 20 // percent of regions with organised evacuation routes
+)
+
+// worldRegions is the built-in map. Names match the labels survivors have always used.
+var worldRegions = []RegionNode{
+	{ID: "mid_atlantic_usa", Name: "Mid-Atlantic, USA", Lat: 39.4, Lon: -77.4, Hub: true},
+	{ID: "northeast_usa", Name: "Northeast USA", Lat: 42.4, Lon: -71.1, Hub: true},
+	{ID: "midwest_usa", Name: "Midwest, USA", Lat: 41.9, Lon: -87.6, Hub: true},
+	{ID: "gulf_coast_usa", Name: "Gulf Coast, USA", Lat: 29.3, Lon: -94.8, Hub: true},
+	{ID: "great_plains_usa", Name: "Great Plains USA", Lat: 41.3, Lon: -98.0, Rural: true},
+	{ID: "west_coast_usa", Name: "West Coast USA", Lat: 37.8, Lon: -122.4, Hub: true},
+	{ID: "south_america", Name: "South America", Lat: -23.5, Lon: -46.6, Hub: true},
+	{ID: "southern_england", Name: "Southern England, UK", Lat: 51.1, Lon: -1.7, Hub: true},
+	{ID: "western_england", Name: "Western England, UK", Lat: 51.5, Lon: -2.6, Rural: true},
+	{ID: "western_europe", Name: "Western Europe", Lat: 48.9, Lon: 2.4, Hub: true},
+	{ID: "western_germany", Name: "Western Germany", Lat: 50.1, Lon: 8.7, Hub: true},
+	{ID: "northern_germany", Name: "Northern Germany", Lat: 54.1, Lon: 13.4},
+	{ID: "northern_europe", Name: "Northern Europe", Lat: 59.3, Lon: 18.1},
+	{ID: "southern_europe", Name: "Southern Europe", Lat: 41.9, Lon: 12.5, Hub: true},
+	{ID: "eastern_europe", Name: "Eastern Europe", Lat: 52.2, Lon: 21.0},
+	{ID: "north_africa", Name: "North Africa", Lat: 30.0, Lon: 31.2, Hub: true},
+	{ID: "western_russia", Name: "Western Russia", Lat: 55.8, Lon: 37.6, Hub: true},
+	{ID: "western_siberia", Name: "Western Siberia, Russia", Lat: 54.9, Lon: 83.1, Rural: true},
+	{ID: "central_china", Name: "Central China", Lat: 30.6, Lon: 114.3, Hub: true},
+	{ID: "eastern_china", Name: "Eastern China", Lat: 31.2, Lon: 121.5, Hub: true},
+	{ID: "south_asia", Name: "South Asia", Lat: 28.6, Lon: 77.2, Hub: true},
+	{ID: "southeast_asia", Name: "Southeast Asia", Lat: 1.4, Lon: 103.8, Hub: true},
+	{ID: "oceania", Name: "Oceania", Lat: -33.9, Lon: 151.2, Hub: true},
+}
+
+// worldLinks are the built-in routes; air links only join hubs.
+var worldLinks = []RegionEdge{
+	{From: "mid_atlantic_usa", To: "northeast_usa", Mode: RouteRoad},
+	{From: "mid_atlantic_usa", To: "northeast_usa", Mode: RouteRail},
+	{From: "mid_atlantic_usa", To: "midwest_usa", Mode: RouteRoad},
+	{From: "midwest_usa", To: "great_plains_usa", Mode: RouteRoad},
+	{From: "great_plains_usa", To: "west_coast_usa", Mode: RouteRoad},
+	{From: "great_plains_usa", To: "gulf_coast_usa", Mode: RouteRoad},
+	{From: "gulf_coast_usa", To: "mid_atlantic_usa", Mode: RouteRoad},
+	{From: "southern_england", To: "western_england", Mode: RouteRoad},
+	{From: "southern_england", To: "western_europe", Mode: RouteRail},
+	{From: "western_europe", To: "western_germany", Mode: RouteRoad},
+	{From: "western_europe", To: "western_germany", Mode: RouteRail},
+	{From: "western_europe", To: "southern_europe", Mode: RouteRoad},
+	{From: "western_germany", To: "northern_germany", Mode: RouteRoad},
+	{From: "western_germany", To: "northern_germany", Mode: RouteRail},
+	{From: "western_germany", To: "eastern_europe", Mode: RouteRail},
+	{From: "northern_germany", To: "northern_europe", Mode: RouteRoad},
+	{From: "northern_germany", To: "eastern_europe", Mode: RouteRoad},
+	{From: "eastern_europe", To: "western_russia", Mode: RouteRoad},
+	{From: "eastern_europe", To: "western_russia", Mode: RouteRail},
+	{From: "western_russia", To: "western_siberia", Mode: RouteRoad},
+	{From: "western_russia", To: "western_siberia", Mode: RouteRail},
+	{From: "central_china", To: "eastern_china", Mode: RouteRoad},
+	{From: "central_china", To: "eastern_china", Mode: RouteRail},
+	{From: "central_china", To: "southeast_asia", Mode: RouteRoad},
+	{From: "northeast_usa", To: "western_europe", Mode: RouteSea},
+	{From: "gulf_coast_usa", To: "south_america", Mode: RouteSea},
+	{From: "west_coast_usa", To: "eastern_china", Mode: RouteSea},
+	{From: "southern_europe", To: "north_africa", Mode: RouteSea},
+	{From: "north_africa", To: "south_asia", Mode: RouteSea},
+	{From: "eastern_china", To: "southeast_asia", Mode: RouteSea},
+	{From: "southeast_asia", To: "south_asia", Mode: RouteSea},
+	{From: "southeast_asia", To: "oceania", Mode: RouteSea},
+	{From: "mid_atlantic_usa", To: "western_europe", Mode: RouteAir},
+	{From: "mid_atlantic_usa", To: "southern_england", Mode: RouteAir},
+	{From: "northeast_usa", To: "southern_england", Mode: RouteAir},
+	{From: "midwest_usa", To: "west_coast_usa", Mode: RouteAir},
+	{From: "gulf_coast_usa", To: "south_america", Mode: RouteAir},
+	{From: "west_coast_usa", To: "eastern_china", Mode: RouteAir},
+	{From: "west_coast_usa", To: "oceania", Mode: RouteAir},
+	{From: "southern_england", To: "south_asia", Mode: RouteAir},
+	{From: "western_europe", To: "southern_europe", Mode: RouteAir},
+	{From: "southern_europe", To: "north_africa", Mode: RouteAir},
+	{From: "western_germany", To: "western_russia", Mode: RouteAir},
+	{From: "western_germany", To: "central_china", Mode: RouteAir},
+	{From: "western_russia", To: "eastern_china", Mode: RouteAir},
+	{From: "central_china", To: "eastern_china", Mode: RouteAir},
+	{From: "eastern_china", To: "southeast_asia", Mode: RouteAir},
+	{From: "southeast_asia", To: "south_asia", Mode: RouteAir},
+	{From: "southeast_asia", To: "oceania", Mode: RouteAir},
+}
+
+// originRegions places each origin site in its region.
+var originRegions = map[string]string{
+	"USAMRIID/Fort Detrick (USA)":         "mid_atlantic_usa",
+	"Galveston National Lab (USA)":        "gulf_coast_usa",
+	"Porton Down (UK)":                    "southern_england",
+	"Vector Institute (Russia)":           "western_siberia",
+	"Riems Island Lab (Germany)":          "northern_germany",
+	"Wuhan Institute of Virology (China)": "central_china",
+}
+
+// originRegionID returns the region holding an origin site, or the first region for unknown sites.
+func originRegionID(origin string) string {
+	if id, ok := originRegions[origin]; ok {
+		return id
+	}
+	return worldRegions[0].ID
+}
+
+// worldRegionByID looks a region up in the built-in map.
+func worldRegionByID(id string) RegionNode {
+	for _, n := range worldRegions {
+		if n.ID == id {
+			return n
+		}
+	}
+	return RegionNode{}
+}
+
+// NewRegionGraph builds the run's world map around the origin site. The stream decides which
+// flight links are grounded and which regions close borders or run evacuations; each region's
+// LAD then comes from ComputeLAD over its shortest spread path from the origin.
+func NewRegionGraph(origin string, stream *Stream) *RegionGraph {
+	g := &RegionGraph{Origin: originRegionID(origin)}
+	g.Nodes = append([]RegionNode{}, worldRegions...)
+	byID := make(map[string]RegionNode, len(g.Nodes))
+	for _, n := range g.Nodes {
+		byID[n.ID] = n
+	}
+	for i, e := range worldLinks {
+		e.KM = math.Round(greatCircleKM(byID[e.From], byID[e.To]))
+		if e.Mode == RouteAir {
+			e.Closed = stream.Child(fmt.Sprintf("link:%d:%s:%s", i, e.From, e.To)).Intn(100) < airClosureChance
+		}
+		g.Edges = append(g.Edges, e)
+	}
+	origNode := byID[g.Origin]
+	dist := g.spreadDistances()
+	for i := range g.Nodes {
+		n := &g.Nodes[i]
+		flags := stream.Child("region:" + n.ID)
+		if n.ID != g.Origin {
+			n.Closures = flags.Child("closures").Intn(100) < closuresChance
+			n.Evac = flags.Child("evac").Intn(100) < evacChance
+		}
+		d, ok := dist[n.ID]
+		if !ok {
+			// cut off entirely: the outbreak arrives with the last stragglers
+			d, n.Unreachable = math.Inf(1), true
+		} else {
+			n.SpreadKM = math.Round(d)
+		}
+		n.OriginKM = math.Round(greatCircleKM(origNode, *n))
+		n.LAD = ComputeLAD(d, n.Hub, n.Rural, n.Closures, n.Evac, flags.Child("lad"))
+	}
+	return g
+}
+
+// spreadDistances runs Dijkstra from the origin over open edges.
+func (g *RegionGraph) spreadDistances() map[string]float64 {
+	adj := map[string][]RegionEdge{}
+	for _, e := range g.Edges {
+		if e.Closed {
+			continue
+		}
+		adj[e.From] = append(adj[e.From], e)
+		adj[e.To] = append(adj[e.To], RegionEdge{From: e.To, To: e.From, Mode: e.Mode, KM: e.KM})
+	}
+	dist := map[string]float64{g.Origin: 0}
+	done := map[string]bool{}
+	for {
+		cur, best := "", math.Inf(1)
+		for _, id := range sortedKeys(dist) {
+			if !done[id] && dist[id] < best {
+				cur, best = id, dist[id]
+			}
+		}
+		if cur == "" {
+			return dist
+		}
+		done[cur] = true
+		for _, e := range adj[cur] {
+			c := routeCost[e.Mode]
+			d := best + e.KM*c.Factor + c.Overhead
+			if old, ok := dist[e.To]; !ok || d < old {
+				dist[e.To] = d
+			}
+		}
+	}
+}
+
+// greatCircleKM is the haversine distance between two regions.
+func greatCircleKM(a, b RegionNode) float64 {
+	const earthKM = 6371.0
+	rad := math.Pi / 180
+	dLat := (b.Lat - a.Lat) * rad
+	dLon := (b.Lon - a.Lon) * rad
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(a.Lat*rad)*math.Cos(b.Lat*rad)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthKM * math.Asin(math.Sqrt(h))
+}
+
+// Node returns the region with the given id.
+func (g *RegionGraph) Node(id string) (RegionNode, bool) {
+	for _, n := range g.Nodes {
+		if n.ID == id {
+			return n, true
+		}
+	}
+	return RegionNode{}, false
+}
+
+// NodeByName returns the region a survivor's Region label refers to.
+func (g *RegionGraph) NodeByName(name string) (RegionNode, bool) {
+	for _, n := range g.Nodes {
+		if n.Name == name {
+			return n, true
+		}
+	}
+	return RegionNode{}, false
+}
+
+// OriginNode returns the region holding the origin site.
+func (g *RegionGraph) OriginNode() RegionNode {
+	n, _ := g.Node(g.Origin)
+	return n
+}
+
+// Neighbors returns the open links leaving a region, ordered by destination and mode.
+func (g *RegionGraph) Neighbors(id string) []RegionEdge {
+	var out []RegionEdge
+	for _, e := range g.Edges {
+		switch {
+		case e.Closed:
+		case e.From == id:
+			out = append(out, e)
+		case e.To == id:
+			out = append(out, RegionEdge{From: e.To, To: e.From, Mode: e.Mode, KM: e.KM})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].To != out[j].To {
+			return out[i].To < out[j].To
+		}
+		return out[i].Mode < out[j].Mode
+	})
+	return out
+}
+
+// pickRegion chooses a region uniformly for a survivor spawning anywhere in the world.
+func (g *RegionGraph) pickRegion(stream *Stream) RegionNode {
+	return g.Nodes[stream.Intn(len(g.Nodes))]
+}
+
+// EnsureRegions builds the region graph for worlds restored without one (runs saved before
+// the graph was persisted). The graph is deterministic for the run's seed and rules.
+func (w *World) EnsureRegions() *RegionGraph {
+	if w.Regions == nil {
+		w.Regions = NewRegionGraph(w.OriginSite, w.Seed.Stream("regions@rules:"+w.RulesVersion))
+	}
+	return w.Regions
+}
+
+// regionGraph is EnsureRegions for an optional world.
+func (w *World) regionGraph() *RegionGraph {
+	if w == nil {
+		return nil
+	}
+	return w.EnsureRegions()
+}
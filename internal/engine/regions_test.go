@@ -0,0 +1,101 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestRegionGraphOriginIsTierA(t *testing.T) {
+	seed, _ := NewRunSeed("regions-origin")
+	for site, id := range originRegions {
+		g := NewRegionGraph(site, seed.Stream("regions"))
+		if g.Origin != id {
+			t.Fatalf("%s: expected origin region %s, got %s", site, id, g.Origin)
+		}
+		if n := g.OriginNode(); n.LAD != 0 || n.SpreadKM != 0 {
+			t.Fatalf("%s: origin region should have LAD 0, got %+v", site, n)
+		}
+	}
+}
+
+func TestRegionGraphLinksAreValid(t *testing.T) {
+	g := NewRegionGraph("Porton Down (UK)", newStream(SeedFromString("regions-links")))
+	for _, e := range worldLinks {
+		from, okFrom := g.Node(e.From)
+		to, okTo := g.Node(e.To)
+		if !okFrom || !okTo {
+			t.Fatalf("link %s-%s references an unknown region", e.From, e.To)
+		}
+		if e.Mode == RouteAir && (!from.Hub || !to.Hub) {
+			t.Fatalf("air link %s-%s must join hubs", e.From, e.To)
+		}
+	}
+}
+
+func TestRegionLADFollowsSpreadDistance(t *testing.T) {
+	seed, _ := NewRunSeed("regions-spread")
+	g := NewRegionGraph("USAMRIID/Fort Detrick (USA)", seed.Stream("regions"))
+	near, _ := g.Node("northeast_usa")
+	far, _ := g.Node("oceania")
+	if near.SpreadKM >= far.SpreadKM {
+		t.Fatalf("expected the neighbouring region to be closer along the graph (%v vs %v)", near.SpreadKM, far.SpreadKM)
+	}
+	if near.LAD > far.LAD {
+		t.Fatalf("expected outbreak to reach %s (LAD %d) before %s (LAD %d)", near.Name, near.LAD, far.Name, far.LAD)
+	}
+}
+
+func TestGroundedFlightsSlowSpread(t *testing.T) {
+	g := NewRegionGraph("USAMRIID/Fort Detrick (USA)", newStream(SeedFromString("regions-grounded")))
+	for i := range g.Edges {
+		g.Edges[i].Closed = false
+	}
+	open := g.spreadDistances()["western_europe"]
+	for i := range g.Edges {
+		if g.Edges[i].Mode == RouteAir {
+			g.Edges[i].Closed = true
+		}
+	}
+	grounded := g.spreadDistances()["western_europe"]
+	if grounded <= open {
+		t.Fatalf("expected grounding flights to lengthen the spread path (%v vs %v)", grounded, open)
+	}
+}
+
+func TestSurvivorsInARegionShareLAD(t *testing.T) {
+	seed, _ := NewRunSeed("regions-shared")
+	w := NewWorld(seed, "1.0.0")
+	lads := map[string]int{}
+	for i := 0; i < 60; i++ {
+		s := NewGenericSurvivor(seed.Stream(fmt.Sprintf("sv:%d", i)), seed, 5, w.Regions)
+		if lad, ok := lads[s.Region]; ok && lad != s.Environment.LAD {
+			t.Fatalf("survivors in %s got LADs %d and %d", s.Region, lad, s.Environment.LAD)
+		}
+		lads[s.Region] = s.Environment.LAD
+		if n, ok := w.Regions.NodeByName(s.Region); !ok || n.LAD != s.Environment.LAD {
+			t.Fatalf("survivor region %q is not on the graph", s.Region)
+		}
+	}
+}
+
+func TestRegionGraphIsDeterministicAndRoundTrips(t *testing.T) {
+	seed, _ := NewRunSeed("regions-persist")
+	a := NewWorld(seed, "1.0.0")
+	b := NewWorld(seed, "1.0.0")
+	if !reflect.DeepEqual(a.Regions, b.Regions) {
+		t.Fatalf("expected the same seed to build the same graph")
+	}
+	data, err := json.Marshal(a.Regions)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var restored RegionGraph
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !reflect.DeepEqual(*a.Regions, restored) {
+		t.Fatalf("expected the graph to survive a JSON round trip")
+	}
+}
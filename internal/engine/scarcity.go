@@ -0,0 +1,61 @@
+package engine
+
+import (
+	"fmt"
+	"math"
+)
+
+// economy tunes how generous the world is with supplies.
+type economy struct {
+	YieldFactor  float64 // multiplier on forage and hunt yields
+	SpoilPerDay  float64 // fraction of the food stock that spoils each day
+	MedicalFind  int     // percent chance a medical item a choice would grant is actually found
+	SupplyRisk   int     // risk tier shift for supply-gathering archetypes
+	NeedsUrgency int     // hunger/thirst level at which the offline planner offers supply runs
+}
+
+var (
+	standardEconomy = economy{YieldFactor: 1, MedicalFind: 100, NeedsUrgency: 60}
+	scarceEconomy   = economy{YieldFactor: 0.6, SpoilPerDay: 0.08, MedicalFind: 50, SupplyRisk: 1, NeedsUrgency: 45}
+)
+
+func economyFor(scarce bool) economy {
+	if scarce {
+		return scarceEconomy
+	}
+	return standardEconomy
+}
+
+// isSupplyArchetype reports whether an archetype's point is bringing supplies in.
+func isSupplyArchetype(a string) bool {
+	return a == "forage" || a == "hunt" || a == "trade"
+}
+
+// spoilFood rots part of the food stock for the days that passed and returns the food days lost.
+func spoilFood(inv *Inventory, days int, econ economy) float64 {
+	if inv == nil || days <= 0 || inv.FoodDays <= 0 || econ.SpoilPerDay <= 0 {
+		return 0
+	}
+	kept := inv.FoodDays * math.Pow(1-econ.SpoilPerDay, float64(days))
+	lost := roundTenth(inv.FoodDays - kept)
+	inv.FoodDays = math.Max(0, roundTenth(inv.FoodDays-lost))
+	return lost
+}
+
+// findItems filters the items a choice would grant; medical items only turn up on a
+// MedicalFind roll, so they are rarer when supplies are scarce.
+func findItems(items []string, econ economy, stream *Stream) []string {
+	if len(items) == 0 {
+		return nil
+	}
+	var found []string
+	for i, item := range items {
+		if _, medical := findTreatment(item); medical && econ.MedicalFind < 100 {
+			if stream == nil || stream.Child(fmt.Sprintf("item:%d", i)).Intn(100) >= econ.MedicalFind {
+				continue
+			}
+		}
+		found = append(found, item)
+	}
+	return found
+}
@@ -0,0 +1,75 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestScarcityLowersYieldsAndMedicalFinds(t *testing.T) {
+	seed, _ := NewRunSeed("scarcity")
+	normalFood, scarceFood, normalMeds, scarceMeds := 0.0, 0.0, 0, 0
+	for i := 0; i < 40; i++ {
+		c := Choice{ID: "f", Archetype: "forage", Risk: RiskLow, Cost: Cost{Time: 1},
+			Effects: ChoiceEffect{GainItems: []string{"bandage"}}}
+		for _, scarce := range []bool{false, true} {
+			s := Survivor{Stats: Stats{Health: 100, Morale: 60}, Skills: map[Skill]int{}, Meters: baselineMeters(), Inventory: Inventory{FoodDays: 3, WaterLiters: 9}}
+			res := ApplyChoice(&s, c, DifficultyStandard, i, seed.Stream(fmt.Sprintf("turn:%d", i)), WithScarcity(scarce))
+			if scarce {
+				scarceFood += res.Supplies.FoodDays
+				scarceMeds += len(res.Gained)
+			} else {
+				normalFood += res.Supplies.FoodDays
+				normalMeds += len(res.Gained)
+			}
+		}
+	}
+	if scarceFood >= normalFood {
+		t.Fatalf("expected scarcity to lower forage yields (normal %.1f, scarce %.1f)", normalFood, scarceFood)
+	}
+	if normalMeds != 40 || scarceMeds == 0 || scarceMeds >= normalMeds {
+		t.Fatalf("expected medical finds to be rarer but possible under scarcity (normal %d, scarce %d)", normalMeds, scarceMeds)
+	}
+}
+
+func TestOnlyScarcitySpoilsFood(t *testing.T) {
+	normal := Inventory{FoodDays: 10}
+	scarce := Inventory{FoodDays: 10}
+	if a, b := spoilFood(&normal, 2, economyFor(false)), spoilFood(&scarce, 2, economyFor(true)); a != 0 || b <= 0 || normal.FoodDays != 10 {
+		t.Fatalf("expected food to spoil only under scarcity: normal lost %.1f, scarce lost %.1f", a, b)
+	}
+}
+
+func TestScarcityRaisesSupplyRiskEverywhere(t *testing.T) {
+	s := Survivor{Stats: Stats{Health: 100}, Skills: map[Skill]int{SkillScavenging: 3}, Meters: baselineMeters()}
+	plain, _, _ := ValidateCustomAction("forage the area", s, 0, DifficultyStandard)
+	scarce, _, _ := ValidateCustomAction("forage the area", s, 0, DifficultyStandard, WithScarcity(true))
+	if riskScore(scarce.Risk) != riskScore(plain.Risk)+1 {
+		t.Fatalf("expected custom forage one tier riskier under scarcity: %s vs %s", plain.Risk, scarce.Risk)
+	}
+	c := Choice{Archetype: "hunt", Risk: RiskLow}
+	adjustRisk(&c, s, choiceConfig{scarcity: true})
+	o := Choice{Archetype: "hunt", Risk: RiskLow}
+	adjustRisk(&o, s, choiceConfig{})
+	if riskScore(c.Risk) <= riskScore(o.Risk) {
+		t.Fatalf("expected planner choices to carry supply risk under scarcity")
+	}
+}
+
+func TestLocalDirectorOffersSupplyRunsUnderScarcity(t *testing.T) {
+	seed, _ := NewRunSeed("scarce-plan")
+	meters := baselineMeters()
+	meters[MeterSupplyBuffer] = 10
+	req := DirectorRequest{
+		Available: []EventBlueprint{catalogByID()["quiet_hour"]},
+		State:     map[string]any{"stats": Stats{Hunger: 50, Thirst: 20}, "meters": meters},
+		Scarcity:  true,
+	}
+	plan, err := NewLocalDirector(seed.Stream("director")).PlanEvent(context.Background(), req)
+	if err != nil {
+		t.Fatalf("plan: %v", err)
+	}
+	if plan.Choices[0].Archetype != "forage" || plan.Choices[1].Archetype != "hunt" {
+		t.Fatalf("expected forage then hunt first under scarcity, got %+v", plan.Choices)
+	}
+}
@@ -14,20 +14,24 @@ const (
 )
 
 type DeltaRange struct {
-	Min int
-	Max int
+	Min int `yaml:"min"`
+	Max int `yaml:"max"`
 }
 
 type ChoiceOutcome map[StatKey]DeltaRange
 
 type ChoiceEffect struct {
-	AddConditions    []Condition
-	RemoveConditions []Condition
-	MeterDeltas      map[Meter]int
+	AddConditions    []Condition       `yaml:"add_conditions"`
+	RemoveConditions []Condition       `yaml:"remove_conditions"`
+	MeterDeltas      map[Meter]int     `yaml:"meter_deltas"`
+	GainItems        []string          `yaml:"gain_items"`
+	LoseItems        []string          `yaml:"lose_items"`
+	Hazards          map[Condition]int `yaml:"hazards"` // percent chance each condition lands on resolution
 }
 
 func (e ChoiceEffect) Empty() bool {
-	return len(e.AddConditions) == 0 && len(e.RemoveConditions) == 0 && len(e.MeterDeltas) == 0
+	return len(e.AddConditions) == 0 && len(e.RemoveConditions) == 0 && len(e.MeterDeltas) == 0 &&
+		len(e.GainItems) == 0 && len(e.LoseItems) == 0 && len(e.Hazards) == 0
 }
 
 func mergeEffects(a, b ChoiceEffect) ChoiceEffect {
@@ -45,6 +49,20 @@ func mergeEffects(a, b ChoiceEffect) ChoiceEffect {
 			res.MeterDeltas[k] += v
 		}
 	}
+	res.GainItems = append(res.GainItems, a.GainItems...)
+	res.GainItems = append(res.GainItems, b.GainItems...)
+	res.LoseItems = append(res.LoseItems, a.LoseItems...)
+	res.LoseItems = append(res.LoseItems, b.LoseItems...)
+	if len(a.Hazards) > 0 || len(b.Hazards) > 0 {
+		res.Hazards = make(map[Condition]int, len(a.Hazards)+len(b.Hazards))
+		for k, v := range a.Hazards {
+			res.Hazards[k] = v
+		}
+		// the same hazard from both sides stacks as two independent rolls
+		for k, v := range b.Hazards {
+			res.Hazards[k] = 100 - (100-res.Hazards[k])*(100-v)/100
+		}
+	}
 	return res
 }
 
@@ -114,6 +132,9 @@ func removeConditionIfPresent(s *Survivor, cond Condition) bool {
 		rest = append(rest, existing)
 	}
 	s.Conditions = rest
+	if removed {
+		delete(s.ConditionDetails, cond)
+	}
 	return removed
 }
 
@@ -128,12 +149,27 @@ type Choice struct {
 	Effects     ChoiceEffect
 	SourceEvent string
 	Custom      bool
+	Item        string            // medical item a medicate choice uses; empty picks the best match
+	Rejected    []EffectRejection // director-proposed effects the engine refused or clamped
+	Route       *TravelRoute      // leg a travel choice follows; nil travel choices stay put
 }
 
 type Resolution struct {
-	Delta   Stats
-	Added   []Condition
-	Removed []Condition
+	Delta    Stats
+	Added    []Condition
+	Removed  []Condition
+	Mishap   string   // mishap table entry that materialized, if any
+	Lost     []string // items given up by the choice or lost to a mishap
+	Supplies SupplyChange
+	Used     string   // medical item consumed by a treatment
+	Gained   []string // items granted by the choice's effects
+	Check    CheckResult
+	XP       int  // experience earned in the choice's relevant skill
+	SkillUp  bool // the relevant skill advanced a level
+	// DaysElapsed counts world-day boundaries crossed by the choice; >0 signals a new day.
+	DaysElapsed int
+	Journey     *Journey         // leg completed by a travel choice
+	Companions  []CompanionEvent // companions hurt, lost or gained during the choice
 }
 
 type conditionOutcome struct {
@@ -143,7 +179,7 @@ type conditionOutcome struct {
 }
 
 type Cost struct {
-	Time    int // abstract units
+	Time    int // time-of-day segments (timeUnitsPerDay per world day)
 	Fatigue int
 	Hunger  int
 	Thirst  int
@@ -179,56 +215,10 @@ func riskFromScore(s int) RiskLevel {
 	return RiskHigh
 }
 
-func isHighExertionChoice(c Choice) bool {
-	if c.Archetype == "rest" || c.Archetype == "organize" {
-		return false
-	}
-	if c.Cost.Fatigue >= 6 {
-		return true
-	}
-	switch c.Archetype {
-	case "forage", "travel", "barricade", "scout":
-		return true
-	default:
-		return false
-	}
-}
-
-// classify archetype category for condition/difficulty modifiers
-func archetypeCategory(a string) string {
-	switch a {
-	case "forage", "travel", "barricade", "scout", "observe":
-		return "physical"
-	case "organize":
-		return "mental"
-	case "rest", "pause":
-		return "rest"
-	default:
-		return "general"
-	}
-}
-
-func relevantSkill(a string) Skill {
-	switch a {
-	case "forage":
-		return SkillScavenging
-	case "observe", "scout", "travel":
-		return SkillNavigation
-	case "organize":
-		return SkillLeadership
-	case "craft":
-		return SkillCrafting
-	case "rest", "pause":
-		return SkillSurvival
-	default:
-		return SkillSurvival
-	}
-}
-
 func adjustRisk(c *Choice, s Survivor, cfg choiceConfig) {
 	base := riskScore(c.Risk)
 	sk := relevantSkill(c.Archetype)
-	lvl := s.Skills[sk]
+	lvl := groupSkill(s, sk)
 	if lvl >= 4 {
 		base--
 	} else if lvl <= 1 {
@@ -261,13 +251,12 @@ func adjustRisk(c *Choice, s Survivor, cfg choiceConfig) {
 	if cfg.difficulty == DifficultyHard && isHighExertionChoice(*c) {
 		base++
 	}
-	// Progressive infected pressure post-arrival
-	if s.Environment.WorldDay >= s.Environment.LAD {
-		days := s.Environment.WorldDay - s.Environment.LAD
-		if days >= 14 && c.Archetype != "rest" {
-			base++
-		}
+	base += traitRiskShift(s.Traits, c.Archetype)
+	if isSupplyArchetype(c.Archetype) {
+		base += economyFor(cfg.scarcity).SupplyRisk
 	}
+	// Progressive infected pressure post-arrival
+	base += infectionRiskShift(s, c.Archetype)
 	if base < 0 {
 		base = 0
 	}
@@ -278,7 +267,12 @@ func adjustRisk(c *Choice, s Survivor, cfg choiceConfig) {
 }
 
 // ApplyChoice applies mechanical deltas and returns resulting resolution summary.
-func ApplyChoice(s *Survivor, c Choice, diff Difficulty, currentTurn int, randStream *Stream) Resolution {
+// Pass WithWorld so the clock can roll the global day forward.
+func ApplyChoice(s *Survivor, c Choice, diff Difficulty, currentTurn int, randStream *Stream, opts ...ChoiceOption) Resolution {
+	cfg := choiceConfig{difficulty: diff}
+	for _, o := range opts {
+		o(&cfg)
+	}
 	result := Resolution{}
 	if s.Meters == nil {
 		s.Meters = make(map[Meter]int)
@@ -288,8 +282,15 @@ func ApplyChoice(s *Survivor, c Choice, diff Difficulty, currentTurn int, randSt
 		seed := Derive(SeedFromString(c.ID), fmt.Sprintf("turn:%d", currentTurn))
 		statStream = newStream(seed)
 	}
-	delta := sampleOutcome(c.Outcome, statStream)
-	delta.Fatigue += c.Cost.Fatigue
+	outcome := c.Outcome
+	skill := relevantSkill(c.Archetype)
+	if c.Archetype != "" {
+		result.Check = skillCheck(groupSkill(*s, skill), c.Risk, statStream.Child("check"))
+		outcome = checkedOutcome(outcome, result.Check)
+	}
+	delta := sampleOutcome(outcome, statStream)
+	delta = addStats(delta, traitOutcome(s.Traits, c.Archetype))
+	delta.Fatigue += traitFatigueCost(s.Traits, c.Cost.Fatigue)
 	delta.Hunger += c.Cost.Hunger
 	delta.Thirst += c.Cost.Thirst
 	baseH, baseT, baseF := 2, 3, 2
@@ -302,15 +303,40 @@ func ApplyChoice(s *Survivor, c Choice, diff Difficulty, currentTurn int, randSt
 	delta.Hunger += baseH
 	delta.Thirst += baseT
 	delta.Fatigue += baseF
+	econ := economyFor(cfg.scarcity)
+	supplies := consumeSupplies(s, c, econ, statStream.Child("supplies"))
+	delta = addStats(delta, supplies.Delta)
+	result.Supplies = supplies.Change
 	s.UpdateStats(delta)
-	added, removed := applyChoiceEffect(s, c.Effects)
+	decayExposure(s, c.Cost.Time)
+	added, removed := applyChoiceEffect(s, exposureEffect(c.Effects, s.Environment))
 	if len(added) > 0 {
 		result.Added = append(result.Added, added...)
 	}
 	if len(removed) > 0 {
 		result.Removed = append(result.Removed, removed...)
 	}
-	condOutcome := advanceConditions(s, diff, c, delta)
+	result.Gained = gainItems(&s.Inventory, findItems(c.Effects.GainItems, econ, statStream.Child("finds")))
+	result.Lost = loseItems(&s.Inventory, c.Effects.LoseItems)
+	result.Added = append(result.Added, rollHazards(s, c.Effects.Hazards, statStream.Child("hazards"))...)
+	if c.Archetype == "medicate" {
+		treated := applyTreatment(s, c.Item)
+		if treated.Item != "" {
+			s.UpdateStats(treated.Delta)
+			delta = addStats(delta, treated.Delta)
+			result.Used = treated.Item
+			result.Removed = append(result.Removed, treated.Removed...)
+		}
+	}
+	mishap := materializeRisk(s, c, diff, statStream.Child("risk"))
+	if mishap.ID != "" {
+		s.UpdateStats(mishap.Delta)
+		delta = addStats(delta, mishap.Delta)
+		result.Mishap = mishap.ID
+		result.Added = append(result.Added, mishap.Added...)
+		result.Lost = append(result.Lost, mishap.LostItems...)
+	}
+	condOutcome := advanceConditions(s, diff, c, delta, currentTurn)
 	if condOutcome.Delta != (Stats{}) {
 		s.UpdateStats(condOutcome.Delta)
 		delta = addStats(delta, condOutcome.Delta)
@@ -321,12 +347,38 @@ func ApplyChoice(s *Survivor, c Choice, diff Difficulty, currentTurn int, randSt
 	if len(condOutcome.Removed) > 0 {
 		result.Removed = append(result.Removed, condOutcome.Removed...)
 	}
+	result.Delta = delta
+	result.Companions = tendCompanions(s, c, result, statStream.Child("companions"))
+	for _, ev := range result.Companions {
+		if ev.Kind == "died" {
+			grief := Stats{Morale: -companionDeathBlow}
+			s.UpdateStats(grief)
+			delta = addStats(delta, grief)
+		}
+	}
 	s.EvaluateDeath()
 	if c.Index == -1 {
-		s.Meters[MeterCustomLastTurn] = currentTurn
+		recordCustomAction(s, c.Archetype, currentTurn)
+	}
+	if xp := checkXP(result.Check, c.Risk); xp > 0 {
+		result.XP = xp
+		result.SkillUp = s.GainSkillXP(skill, xp)
+	}
+	departDay := s.Environment.WorldDay
+	result.DaysElapsed = advanceClock(s, cfg.world, c.Cost.Time)
+	if c.Route != nil {
+		j := arrive(s, *c.Route, cfg.world.regionGraph(), departDay)
+		for i := 0; i < j.FuelUsed; i++ {
+			result.Lost = append(result.Lost, fuelItem)
+		}
+		result.Journey = &j
+		if c.Route.CrossesRegions() {
+			cfg.world.SyncWeather(s)
+		}
 	}
-	if c.Archetype != "" {
-		s.GainSkill(relevantSkill(c.Archetype), true)
+	if spoiled := spoilFood(&s.Inventory, result.DaysElapsed, econ); spoiled > 0 {
+		result.Supplies.Spoiled = spoiled
+		result.Supplies.FoodDays = roundTenth(result.Supplies.FoodDays - spoiled)
 	}
 	result.Delta = delta
 	return result
@@ -388,9 +440,11 @@ type choiceConfig struct {
 	textDensity string
 	infected    bool
 	difficulty  Difficulty
+	world       *World
 }
 
 func WithScarcity(b bool) ChoiceOption         { return func(c *choiceConfig) { c.scarcity = b } }
 func WithTextDensity(d string) ChoiceOption    { return func(c *choiceConfig) { c.textDensity = d } }
 func WithInfectedPresent(b bool) ChoiceOption  { return func(c *choiceConfig) { c.infected = b } }
 func WithDifficulty(d Difficulty) ChoiceOption { return func(c *choiceConfig) { c.difficulty = d } }
+func WithWorld(w *World) ChoiceOption          { return func(c *choiceConfig) { c.world = w } }
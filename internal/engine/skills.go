@@ -0,0 +1,140 @@
+package engine
+
+// CheckResult is the outcome of the skill check made when a choice resolves.
+type CheckResult string
+
+const (
+	CheckSuccess CheckResult = "success"
+	CheckPartial CheckResult = "partial"
+	CheckFailure CheckResult = "failure"
+)
+
+const (
+	maxSkillLevel = 5
+	partialBand   = 30 // roll window above the success chance that still counts as partial
+	failurePush   = 2  // how far a failure drags each stat past the bad end of its range
+)
+
+// checkChance returns the percentage chance of a clean success for a skill level against a risk tier.
+func checkChance(level int, risk RiskLevel) int {
+	chance := 45 + level*10 - riskScore(risk)*15
+	if chance < 5 {
+		chance = 5
+	}
+	if chance > 95 {
+		chance = 95
+	}
+	return chance
+}
+
+// skillCheck rolls a choice's relevant skill against its risk.
+func skillCheck(level int, risk RiskLevel, stream *Stream) CheckResult {
+	if stream == nil {
+		return CheckPartial
+	}
+	roll := stream.Intn(100)
+	chance := checkChance(level, risk)
+	switch {
+	case roll < chance:
+		return CheckSuccess
+	case roll < chance+partialBand:
+		return CheckPartial
+	default:
+		return CheckFailure
+	}
+}
+
+// higherIsBetter reports whether gains in the stat help the survivor.
+func higherIsBetter(key StatKey) bool {
+	return key == StatHealth || key == StatMorale
+}
+
+// shiftRange narrows a delta range to its good half on success and to its bad half on failure;
+// failures also push past the bad end. Partial results keep the full range.
+func shiftRange(key StatKey, rng DeltaRange, check CheckResult) DeltaRange {
+	if rng.Min > rng.Max {
+		rng.Min, rng.Max = rng.Max, rng.Min
+	}
+	mid := rng.Min + (rng.Max-rng.Min)/2
+	good := higherIsBetter(key)
+	switch check {
+	case CheckSuccess:
+		if good {
+			return DeltaRange{Min: mid, Max: rng.Max}
+		}
+		return DeltaRange{Min: rng.Min, Max: mid}
+	case CheckFailure:
+		if good {
+			return DeltaRange{Min: rng.Min - failurePush, Max: mid - failurePush}
+		}
+		return DeltaRange{Min: mid + failurePush, Max: rng.Max + failurePush}
+	default:
+		return rng
+	}
+}
+
+// checkedOutcome applies a skill check result to every range of a choice outcome.
+func checkedOutcome(out ChoiceOutcome, check CheckResult) ChoiceOutcome {
+	if len(out) == 0 || check == CheckPartial || check == "" {
+		return out
+	}
+	shifted := make(ChoiceOutcome, len(out))
+	for k, rng := range out {
+		shifted[k] = shiftRange(k, rng, check)
+	}
+	return shifted
+}
+
+// checkXP returns the experience a check result earns. Routine (low-risk) work and
+// partial results teach nothing; failures teach less than hard-won successes.
+func checkXP(check CheckResult, risk RiskLevel) int {
+	tier := riskScore(risk)
+	if tier == 0 {
+		return 0
+	}
+	switch check {
+	case CheckSuccess:
+		return 2 * tier
+	case CheckFailure:
+		return tier
+	default:
+		return 0
+	}
+}
+
+// xpToNext is the experience needed to advance from a level; it grows quadratically
+// so each level takes longer to earn than the last.
+func xpToNext(level int) int {
+	return 4 * (level + 1) * (level + 1)
+}
+
+// GainSkillXP adds experience to a skill and advances its level when the threshold is reached.
+// Returns true when the skill levelled up.
+func (s *Survivor) GainSkillXP(sk Skill, xp int) bool {
+	if xp <= 0 {
+		return false
+	}
+	if s.Skills == nil {
+		s.Skills = make(map[Skill]int)
+	}
+	if s.SkillXP == nil {
+		s.SkillXP = make(map[Skill]int)
+	}
+	level := s.Skills[sk]
+	if level >= maxSkillLevel {
+		return false
+	}
+	progress := s.SkillXP[sk] + xp
+	leveled := false
+	for level < maxSkillLevel && progress >= xpToNext(level) {
+		progress -= xpToNext(level)
+		level++
+		leveled = true
+	}
+	if level >= maxSkillLevel {
+		progress = 0
+	}
+	s.Skills[sk] = level
+	s.SkillXP[sk] = progress
+	return leveled
+}
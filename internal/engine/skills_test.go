@@ -0,0 +1,84 @@
+package engine
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRestingNoLongerMaxesSkills(t *testing.T) {
+	seed, _ := NewRunSeed("skill-rest")
+	s := Survivor{Stats: Stats{Health: 100, Morale: 60}, Skills: map[Skill]int{}, Meters: baselineMeters()}
+	rest := Choice{ID: "r", Archetype: "rest", Risk: RiskLow, Outcome: ChoiceOutcome{StatFatigue: {Min: -12, Max: -8}}, Cost: Cost{Time: 1}}
+	for i := 0; i < 10; i++ {
+		res := ApplyChoice(&s, rest, DifficultyStandard, i, seed.Stream(fmt.Sprintf("turn:%d", i)))
+		if res.XP != 0 {
+			t.Fatalf("routine rest should not earn xp, got %d", res.XP)
+		}
+	}
+	if s.Skills[SkillSurvival] != 0 {
+		t.Fatalf("expected survival to stay at 0 after resting, got %d", s.Skills[SkillSurvival])
+	}
+}
+
+func TestSkillXPDiminishingReturns(t *testing.T) {
+	s := Survivor{}
+	if !s.GainSkillXP(SkillScavenging, xpToNext(0)) || s.Skills[SkillScavenging] != 1 {
+		t.Fatalf("expected first level after %d xp, got level %d", xpToNext(0), s.Skills[SkillScavenging])
+	}
+	if s.GainSkillXP(SkillScavenging, xpToNext(0)) {
+		t.Fatalf("expected the same xp not to buy the next level")
+	}
+	s.GainSkillXP(SkillScavenging, 1000)
+	if s.Skills[SkillScavenging] != maxSkillLevel || s.SkillXP[SkillScavenging] != 0 {
+		t.Fatalf("expected skill capped at %d with no banked xp, got %d/%d", maxSkillLevel, s.Skills[SkillScavenging], s.SkillXP[SkillScavenging])
+	}
+}
+
+func TestSkillCheckShiftsOutcome(t *testing.T) {
+	out := ChoiceOutcome{StatHunger: {Min: -8, Max: -4}, StatMorale: {Min: 1, Max: 3}}
+	win := checkedOutcome(out, CheckSuccess)
+	if win[StatHunger].Max > out[StatHunger].Max-2 || win[StatMorale].Min < 2 {
+		t.Fatalf("success should keep the good half: %+v", win)
+	}
+	loss := checkedOutcome(out, CheckFailure)
+	if loss[StatHunger].Max <= out[StatHunger].Max || loss[StatMorale].Min >= out[StatMorale].Min {
+		t.Fatalf("failure should push past the bad end: %+v", loss)
+	}
+}
+
+func TestSkillCheckFavoursSkill(t *testing.T) {
+	seed, _ := NewRunSeed("skill-check")
+	novice, expert := 0, 0
+	for i := 0; i < 300; i++ {
+		stream := seed.Stream(fmt.Sprintf("c:%d", i))
+		if skillCheck(0, RiskHigh, stream) == CheckSuccess {
+			novice++
+		}
+		if skillCheck(5, RiskHigh, stream) == CheckSuccess {
+			expert++
+		}
+	}
+	if expert <= novice {
+		t.Fatalf("expected skilled survivors to succeed more (novice=%d expert=%d)", novice, expert)
+	}
+}
+
+func TestRiskyChoicesReportCheckAndXP(t *testing.T) {
+	seed, _ := NewRunSeed("skill-xp")
+	earned := 0
+	for i := 0; i < 50; i++ {
+		s := Survivor{Stats: Stats{Health: 100, Morale: 60}, Skills: map[Skill]int{}, Meters: baselineMeters()}
+		c := Choice{ID: "s", Archetype: "scout", Risk: RiskHigh, Outcome: ChoiceOutcome{StatMorale: {Min: 1, Max: 2}}}
+		res := ApplyChoice(&s, c, DifficultyStandard, i, seed.Stream(fmt.Sprintf("turn:%d", i)))
+		if res.Check == "" {
+			t.Fatalf("expected a skill check result")
+		}
+		if res.Check == CheckPartial && res.XP != 0 {
+			t.Fatalf("partial results should not earn xp")
+		}
+		earned += res.XP
+	}
+	if earned == 0 {
+		t.Fatalf("expected risky scouting to earn some xp")
+	}
+}
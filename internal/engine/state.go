@@ -1,7 +1,6 @@
 package engine
 
 import (
-	"strings"
 	"time"
 )
 
@@ -10,27 +9,33 @@ type World struct {
 	OriginSite   string
 	Seed         RunSeed
 	RulesVersion string
-	CurrentDay   int // advances globally; survivors spawn into this
+	ContentPacks []string     // content pack refs (id@version) the run's event catalog was built from
+	CurrentDay   int          // advances globally; survivors spawn into this
+	Regions      *RegionGraph // outbreak spread map; fixes each region's LAD
 }
 
 // Survivor represents an in-game character.
 type Survivor struct {
-	Name        string
-	Age         int
-	Background  string
-	Region      string
-	Location    LocationType
-	Group       GroupType
-	GroupSize   int
-	Traits      []Trait
-	Skills      map[Skill]int // 0-5 inclusive
-	Stats       Stats
-	BodyTemp    TempBand
-	Conditions  []Condition
-	Meters      map[Meter]int // 0-100 internal scaling for now
-	Inventory   Inventory
-	Environment Environment
-	Alive       bool
+	Name             string
+	Age              int
+	Background       string
+	Region           string
+	Location         LocationType
+	Group            GroupType
+	GroupSize        int         // survivor plus active companions
+	Companions       []Companion // everyone who has travelled with the survivor, including the dead and departed
+	Traits           []Trait
+	Skills           map[Skill]int // 0-5 inclusive
+	SkillXP          map[Skill]int // experience toward each skill's next level
+	Stats            Stats
+	BodyTemp         TempBand
+	Conditions       []Condition
+	ConditionDetails map[Condition]ConditionState // severity and onset per active condition
+	Meters           map[Meter]int                // 0-100 internal scaling for now
+	LastCustom       string                       // archetype of the most recent custom action
+	Inventory        Inventory
+	Environment      Environment
+	Alive            bool
 }
 
 type Stats struct {
@@ -41,6 +46,13 @@ type Stats struct {
 	Morale  int
 }
 
+// ConditionState is the progression record for one active condition.
+type ConditionState struct {
+	Severity  int // 1-5; untreated conditions worsen over time
+	OnsetTurn int
+	Scenes    int // scenes the condition has persisted
+}
+
 type Inventory struct {
 	Weapons     []string
 	Ammo        map[string]int
@@ -112,27 +124,6 @@ func ComputeLAD(distanceKM float64, hub bool, rural bool, closures bool, evac bo
 	return lad
 }
 
-func deriveInitialLAD(stream *Stream, loc LocationType) int {
-	// Distance heuristics per location tier
-	distStream := stream.Child("distance")
-	var distance float64
-	switch loc {
-	case LocationCity, LocationHarbor, LocationAirport:
-		distance = 50 + distStream.Float64()*150 // 50-200
-	case LocationSuburb, LocationIndustrial, LocationMegastructure, LocationStronghold:
-		distance = 150 + distStream.Float64()*600 // 150-750
-	case LocationRural, LocationResearchOutpost, LocationCanyon, LocationForest, LocationCoast, LocationMountain, LocationDesert, LocationIsland, LocationMarsh, LocationPlateau, LocationTundra, LocationSubterranean:
-		distance = 400 + distStream.Float64()*2600 // 400-3000
-	default:
-		distance = 500 + distStream.Float64()*2000
-	}
-	hub := loc == LocationCity || loc == LocationHarbor || loc == LocationAirport
-	ruralFlag := loc == LocationRural || loc == LocationResearchOutpost || loc == LocationStronghold || loc == LocationCanyon
-	closures := distStream.Child("closures").Float64() < 0.15
-	evac := distStream.Child("evac").Float64() < 0.20
-	return ComputeLAD(distance, hub, ruralFlag, closures, evac, stream.Child("lad"))
-}
-
 // Clamp stat into 0-100.
 func Clamp(v int) int {
 	if v < 0 {
@@ -410,8 +401,9 @@ func randomSurname(stream *Stream) string {
 	return surnames[stream.Child("surname").Intn(len(surnames))]
 }
 
-// NewFirstSurvivor generates the initial survivor per first-run rule.
-func NewFirstSurvivor(stream *Stream, originRegion string) Survivor {
+// NewFirstSurvivor generates the initial survivor per first-run rule. The run seed fixes the
+// region's weather, so the survivor spawns into the same sky as anyone else there that day.
+func NewFirstSurvivor(stream *Stream, seed RunSeed, originRegion string) Survivor {
 	roleStream := stream.Child("role")
 	researcher := roleStream.Float64() < 0.05
 	worldDay := 0
@@ -467,12 +459,9 @@ func NewFirstSurvivor(stream *Stream, originRegion string) Survivor {
 	zones := []string{"UTC", "America/New_York", "Europe/London", "Asia/Shanghai", "Europe/Berlin", "America/Chicago"}
 	zone := zones[zoneStream.Intn(len(zones))]
 
-	// derive a non-revealing region label for UI
-	regionLabel := generalRegion(originRegion, stream.Child("region-label"))
-	seasonStream := stream.Child("season")
-	season := randomSeason(seasonStream)
-	weather := randomWeather(seasonStream.Child("weather"), season)
-	tempBand := tempBandForSeason(seasonStream.Child("temp"), season)
+	// the origin region's coarse label doesn't reveal the site
+	regionLabel := worldRegionByID(originRegionID(originRegion)).Name
+	season, weather, tempBand := RegionWeather(seed, regionLabel, worldDay)
 	env := Environment{
 		WorldDay:           worldDay,
 		TimeOfDay:          initialTOD(stream.Child("tod")),
@@ -505,16 +494,18 @@ func NewFirstSurvivor(stream *Stream, originRegion string) Survivor {
 		Environment: env,
 		Alive:       true,
 	}
+	survivor.updateInfectionPresence()
 	return survivor
 }
 
 func initialTOD(stream *Stream) string {
-	segments := []string{"pre-dawn", "morning", "midday", "afternoon", "evening", "night"}
-	return segments[stream.Intn(len(segments))]
+	return timeOfDaySegments[stream.Intn(len(timeOfDaySegments))]
 }
 
-// NewGenericSurvivor generates a replacement survivor using broader randomization.
-func NewGenericSurvivor(stream *Stream, worldDay int, originRegion string) Survivor {
+// NewGenericSurvivor generates a replacement survivor using broader randomization. Pass the
+// world's Regions so the survivor takes its region's shared LAD; a nil graph is built from
+// the stream alone. Season and weather come from the run seed's regional weather.
+func NewGenericSurvivor(stream *Stream, seed RunSeed, worldDay int, regions *RegionGraph) Survivor {
 	traitStream := stream.Child("traits")
 	traitCount := 2 + traitStream.Child("count").Intn(2)
 	traits := selectTraits(traitStream, traitCount)
@@ -532,7 +523,12 @@ func NewGenericSurvivor(stream *Stream, worldDay int, originRegion string) Survi
 
 	locs := []LocationType{LocationCity, LocationSuburb, LocationRural, LocationForest, LocationCoast, LocationIndustrial, LocationMegastructure, LocationCanyon, LocationHarbor, LocationAirport, LocationResearchOutpost, LocationStronghold}
 	loc := locs[stream.Child("location").Intn(len(locs))]
-	lad := deriveInitialLAD(stream.Child("lad"), loc)
+	if regions == nil {
+		regions = NewRegionGraph("", stream.Child("regions"))
+	}
+	// generic survivors may be anywhere in the world
+	region := regions.pickRegion(stream.Child("world-region"))
+	lad := region.LAD
 
 	prof := pickProfession(stream.Child("profession"))
 	inv := baseInventory(stream.Child("inventory"))
@@ -556,14 +552,11 @@ func NewGenericSurvivor(stream *Stream, worldDay int, originRegion string) Survi
 
 	nameStream := stream.Child("name")
 	fullName := randomName(nameStream) + " " + randomSurname(nameStream)
+	companions := newCompanions(groupStream.Child("companions"), gSize-1, fullName)
 	zones := []string{"UTC", "America/New_York", "Europe/London", "Asia/Shanghai", "Europe/Berlin", "America/Chicago", "Australia/Sydney"}
 	zone := zones[stream.Child("timezone").Intn(len(zones))]
-	// generic survivors may be anywhere in the world
-	regionLabel := pickWorldRegion(stream.Child("world-region"))
-	seasonStream := stream.Child("season")
-	season := randomSeason(seasonStream)
-	weather := randomWeather(seasonStream.Child("weather"), season)
-	tempBand := tempBandForSeason(seasonStream.Child("temp"), season)
+	regionLabel := region.Name
+	season, weather, tempBand := RegionWeather(seed, regionLabel, worldDay)
 
 	survivor := Survivor{
 		Name:       fullName,
@@ -573,6 +566,7 @@ func NewGenericSurvivor(stream *Stream, worldDay int, originRegion string) Survi
 		Location:   loc,
 		Group:      g,
 		GroupSize:  gSize,
+		Companions: companions,
 		Traits:     traits,
 		Skills:     skills,
 		Stats:      stats,
@@ -581,19 +575,22 @@ func NewGenericSurvivor(stream *Stream, worldDay int, originRegion string) Survi
 		Meters:     baselineMeters(),
 		Inventory:  inv,
 		Environment: Environment{
-			WorldDay:  worldDay,
-			TimeOfDay: initialTOD(stream.Child("tod")),
-			Season:    season,
-			Weather:   weather,
-			TempBand:  tempBand,
-			Region:    regionLabel,
-			Location:  loc,
-			LAD:       lad,
-			Infected:  worldDay >= lad,
-			Timezone:  zone,
+			WorldDay:           worldDay,
+			TimeOfDay:          initialTOD(stream.Child("tod")),
+			Season:             season,
+			Weather:            weather,
+			TempBand:           tempBand,
+			Region:             regionLabel,
+			Location:           loc,
+			LAD:                lad,
+			Infected:           worldDay >= lad,
+			Timezone:           zone,
+			DistanceToOriginKM: region.OriginKM,
 		},
 		Alive: true,
 	}
+	survivor.syncGroup()
+	survivor.syncInfection(regions)
 	return survivor
 }
 
@@ -632,9 +629,10 @@ func baselineMeters() map[Meter]int {
 		MeterWarmStreak:             0,
 		MeterExhaustionScenes:       0,
 		MeterCustomLastTurn:         -10,
+		MeterCustomStreak:           0,
 		MeterStealthProfile:         0,
 		MeterLeadershipTrust:        0,
-		MeterSupplyOutlook:          0,
+		MeterSupplyOutlook:          50,
 		MeterCommunitySentiment:     0,
 		MeterFortificationIntegrity: 0,
 		MeterRadiationExposure:      0,
@@ -653,90 +651,6 @@ func baseInventory(stream *Stream) Inventory {
 	}
 }
 
-// generalRegion maps a hidden origin site string to a non-revealing coarse region label.
-func generalRegion(origin string, stream *Stream) string {
-	// Attempt to read country from parentheses
-	country := ""
-	if i := strings.LastIndex(origin, "("); i >= 0 && strings.HasSuffix(origin, ")") {
-		country = strings.TrimSuffix(origin[i+1:], ")")
-	}
-	switch country {
-	case "USA":
-		labs := []string{"Mid-Atlantic, USA", "Gulf Coast, USA", "Midwest, USA"}
-		return labs[stream.Intn(len(labs))]
-	case "UK":
-		opts := []string{"Southern England, UK", "Western England, UK"}
-		return opts[stream.Intn(len(opts))]
-	case "Germany":
-		opts := []string{"Northern Germany", "Western Germany"}
-		return opts[stream.Intn(len(opts))]
-	case "China":
-		opts := []string{"Central China", "Eastern China"}
-		return opts[stream.Intn(len(opts))]
-	case "Russia":
-		opts := []string{"Western Russia"}
-		return opts[stream.Intn(len(opts))]
-	default:
-		// Fallback coarse label
-		return "Unknown Region"
-	}
-}
-
-// pickWorldRegion selects a broad world region label for replacement survivors.
-func pickWorldRegion(stream *Stream) string {
-	regions := []string{
-		"Northeast USA", "West Coast USA", "Great Plains USA",
-		"Western Europe", "Northern Europe", "Southern Europe",
-		"Eastern Europe", "Central China", "Eastern China", "South Asia",
-		"Southeast Asia", "Oceania", "South America", "North Africa",
-	}
-	return regions[stream.Intn(len(regions))]
-}
-
-func randomSeason(stream *Stream) Season {
-	return AllSeasons[stream.Intn(len(AllSeasons))]
-}
-
-func randomWeather(stream *Stream, season Season) Weather {
-	var options []Weather
-	switch season {
-	case SeasonWinter:
-		options = []Weather{WeatherSnow, WeatherBlizzard, WeatherClear, WeatherOvercast, WeatherFog}
-	case SeasonSummer:
-		options = []Weather{WeatherClear, WeatherHeatwave, WeatherRain, WeatherStorm, WeatherDustStorm}
-	case SeasonAutumn:
-		options = []Weather{WeatherRain, WeatherFog, WeatherOvercast, WeatherClear, WeatherStorm}
-	default:
-		options = []Weather{WeatherRain, WeatherClear, WeatherOvercast, WeatherMonsoon, WeatherFog}
-	}
-	return options[stream.Intn(len(options))]
-}
-
-func tempBandForSeason(stream *Stream, season Season) TempBand {
-	switch season {
-	case SeasonWinter:
-		if stream.Intn(2) == 0 {
-			return TempCold
-		}
-		return TempFreezing
-	case SeasonSummer:
-		if stream.Intn(3) == 0 {
-			return TempScorching
-		}
-		return TempWarm
-	case SeasonAutumn:
-		if stream.Intn(2) == 0 {
-			return TempMild
-		}
-		return TempCold
-	default:
-		if stream.Intn(2) == 0 {
-			return TempMild
-		}
-		return TempWarm
-	}
-}
-
 // AdvanceDay increments global day.
 func (w *World) AdvanceDay() { w.CurrentDay++ }
 
@@ -762,7 +676,9 @@ func (s *Survivor) EvaluateDeath() {
 // NewWorld initialises world data using deterministic seeding.
 func NewWorld(seed RunSeed, rulesVersion string) *World {
 	origin := pickOrigin(seed.Stream("origin@rules:" + rulesVersion))
-	return &World{OriginSite: origin, Seed: seed, RulesVersion: rulesVersion, CurrentDay: 0}
+	w := &World{OriginSite: origin, Seed: seed, RulesVersion: rulesVersion, ContentPacks: ActiveContentPacks(), CurrentDay: 0}
+	w.EnsureRegions()
+	return w
 }
 
 func pickOrigin(stream *Stream) string {
@@ -779,7 +695,7 @@ func pickOrigin(stream *Stream) string {
 
 // NarrativeState collects survivor info for narration/UI.
 func (s Survivor) NarrativeState() map[string]any {
-	return map[string]any{
+	state := map[string]any{
 		"name":             s.Name,
 		"age":              s.Age,
 		"background":       s.Background,
@@ -803,6 +719,14 @@ func (s Survivor) NarrativeState() map[string]any {
 		"timezone":         s.Environment.Timezone,
 		"local_datetime":   narrativeLocalTime(s),
 	}
+	if companions := companionNarrative(s); len(companions) > 0 {
+		state["companions"] = companions
+	}
+	if s.Environment.Infected {
+		// only once infected are present, so pre-arrival narration can't hint at them
+		state["infected_behavior"] = InfectedBehaviorOn(s.Environment.WorldDay)
+	}
+	return state
 }
 
 func narrativeLocalTime(s Survivor) string {
@@ -845,16 +769,5 @@ func (s *Survivor) SyncEnvironmentDay(day int) {
 }
 
 func (s *Survivor) updateInfectionPresence() {
-	s.Environment.Infected = s.Environment.WorldDay >= s.Environment.LAD
-}
-
-// Survivor skill advancement logic.
-func (s *Survivor) GainSkill(sk Skill, meaningful bool) {
-	if !meaningful {
-		return
-	}
-	cur := s.Skills[sk]
-	if cur < 5 {
-		s.Skills[sk] = cur + 1
-	}
+	s.syncInfection(nil)
 }
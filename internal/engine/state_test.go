@@ -4,7 +4,7 @@ import "testing"
 
 func TestFirstSurvivorLADZero(t *testing.T) {
     seed, _ := NewRunSeed("lad-first")
-    s := NewFirstSurvivor(seed.Stream("s"), "USAMRIID/Fort Detrick (USA)")
+    s := NewFirstSurvivor(seed.Stream("s"), seed, "USAMRIID/Fort Detrick (USA)")
     if s.Environment.LAD != 0 {
         t.Fatalf("expected first survivor LAD=0, got %d", s.Environment.LAD)
     }
@@ -0,0 +1,138 @@
+package engine
+
+import "math"
+
+// timeUnitsPerDay is the number of Cost.Time units in one world day (one per time-of-day segment).
+const timeUnitsPerDay = 6
+
+const (
+	waterLitersPerDay = 3.0 // per person
+	foodRelief        = 2   // hunger offset per time unit when rations are eaten
+	waterRelief       = 3   // thirst offset per time unit when water is drunk
+	starvePenalty     = 2   // extra hunger per time unit without food
+	parchPenalty      = 3   // extra thirst per time unit without water
+	heatWaterFactor   = 1.5 // water need multiplier under heat exposure
+)
+
+// SupplyChange reports how food and water stocks moved during a resolution.
+type SupplyChange struct {
+	FoodDays    float64
+	WaterLiters float64
+	Spoiled     float64 // food days lost to spoilage, already included in FoodDays
+}
+
+type supplyOutcome struct {
+	Delta  Stats
+	Change SupplyChange
+}
+
+// consumeSupplies draws down food and water for the time spent on a choice and adds foraged
+// stock, scaled by the economy's yield factor.
+func consumeSupplies(s *Survivor, c Choice, econ economy, stream *Stream) supplyOutcome {
+	out := supplyOutcome{}
+	if s == nil {
+		return out
+	}
+	beforeFood, beforeWater := s.Inventory.FoodDays, s.Inventory.WaterLiters
+	if c.Archetype == "forage" {
+		food, water := forageYield(s.Skills[SkillScavenging], stream)
+		s.Inventory.FoodDays += roundTenth(food * econ.YieldFactor)
+		s.Inventory.WaterLiters += roundTenth(water * econ.YieldFactor)
+	}
+	if c.Archetype == "hunt" {
+		s.Inventory.FoodDays += roundTenth(huntYield(s.Skills[SkillSurvival], stream) * econ.YieldFactor)
+	}
+	units := c.Cost.Time
+	if units > 0 {
+		foodNeed, waterNeed := suppliesNeeded(*s, units)
+		foodFrac := drawStock(&s.Inventory.FoodDays, foodNeed)
+		waterFrac := drawStock(&s.Inventory.WaterLiters, waterNeed)
+		out.Delta.Hunger = needDelta(units, foodFrac, foodRelief, starvePenalty)
+		out.Delta.Thirst = needDelta(units, waterFrac, waterRelief, parchPenalty)
+	}
+	out.Change = SupplyChange{
+		FoodDays:    roundTenth(s.Inventory.FoodDays - beforeFood),
+		WaterLiters: roundTenth(s.Inventory.WaterLiters - beforeWater),
+	}
+	updateSupplyMeters(s, out.Change)
+	return out
+}
+
+// suppliesNeeded is the food (days) and water (liters) the survivor's group gets through
+// over the given time units.
+func suppliesNeeded(s Survivor, units int) (food, water float64) {
+	people := float64(groupHeadcount(s))
+	food = float64(units) * people / timeUnitsPerDay
+	water = float64(units) * people * waterLitersPerDay / timeUnitsPerDay
+	if isHeatExposure(s.Environment) {
+		water *= heatWaterFactor
+	}
+	return food, water
+}
+
+// drawStock removes up to need from stock and returns the fraction of need that was met.
+func drawStock(stock *float64, need float64) float64 {
+	if need <= 0 {
+		return 1
+	}
+	if *stock >= need {
+		*stock -= need
+		return 1
+	}
+	frac := *stock / need
+	*stock = 0
+	return frac
+}
+
+func needDelta(units int, met float64, relief, penalty int) int {
+	fed := met * float64(units*relief)
+	short := (1 - met) * float64(units*penalty)
+	return int(math.Round(short - fed))
+}
+
+// forageYield returns food days and water liters gathered by a forage action.
+func forageYield(skill int, stream *Stream) (float64, float64) {
+	food := 0.2 + 0.1*float64(skill)
+	water := 0.3 + 0.15*float64(skill)
+	if stream != nil {
+		food += stream.Child("food").Float64() * 0.4
+		water += stream.Child("water").Float64() * 0.6
+	}
+	return roundTenth(food), roundTenth(water)
+}
+
+// huntYield returns food days brought back by a hunt; no water, but more food than foraging.
+func huntYield(skill int, stream *Stream) float64 {
+	food := 0.3 + 0.15*float64(skill)
+	if stream != nil {
+		food += stream.Child("hunt").Float64() * 0.8
+	}
+	return roundTenth(food)
+}
+
+// supplyDays projects how many days current stocks last the group, limited by the scarcer resource.
+func supplyDays(s Survivor) float64 {
+	people := float64(groupHeadcount(s))
+	food := s.Inventory.FoodDays / people
+	water := s.Inventory.WaterLiters / (people * waterLitersPerDay)
+	return math.Min(food, water)
+}
+
+// updateSupplyMeters keeps the buffer (projected days x10) and outlook (trend, 50 = steady) current.
+func updateSupplyMeters(s *Survivor, change SupplyChange) {
+	if s.Meters == nil {
+		s.Meters = make(map[Meter]int)
+	}
+	s.Meters[MeterSupplyBuffer] = Clamp(int(math.Round(supplyDays(*s) * 10)))
+	trend := 50 + int(math.Round((change.FoodDays+change.WaterLiters/waterLitersPerDay)*50))
+	s.Meters[MeterSupplyOutlook] = Clamp((s.Meters[MeterSupplyOutlook] + Clamp(trend)) / 2)
+}
+
+func groupHeadcount(s Survivor) int {
+	if s.GroupSize < 1 {
+		return 1
+	}
+	return s.GroupSize
+}
+
+func roundTenth(v float64) float64 { return math.Round(v*10) / 10 }
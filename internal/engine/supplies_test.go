@@ -0,0 +1,51 @@
+package engine
+
+import "testing"
+
+func TestSuppliesConsumedOverTime(t *testing.T) {
+	s := Survivor{Stats: Stats{Health: 100, Hunger: 30, Thirst: 30}, GroupSize: 1, Meters: baselineMeters(), Inventory: Inventory{FoodDays: 1, WaterLiters: 3}}
+	res := ApplyChoice(&s, Choice{ID: "wait", Cost: Cost{Time: 3}}, DifficultyStandard, 0, nil)
+	if s.Inventory.FoodDays != 0.5 || s.Inventory.WaterLiters != 1.5 {
+		t.Fatalf("expected half a day of supplies consumed, got food=%.2f water=%.2f", s.Inventory.FoodDays, s.Inventory.WaterLiters)
+	}
+	if res.Supplies.FoodDays != -0.5 || res.Supplies.WaterLiters != -1.5 {
+		t.Fatalf("unexpected supply change: %+v", res.Supplies)
+	}
+	if s.Meters[MeterSupplyBuffer] != 5 {
+		t.Fatalf("expected supply buffer of 5 (0.5 days), got %d", s.Meters[MeterSupplyBuffer])
+	}
+}
+
+func TestRunningOutDrivesNeedsUp(t *testing.T) {
+	fed := Survivor{Stats: Stats{Health: 100}, Meters: baselineMeters(), Inventory: Inventory{FoodDays: 5, WaterLiters: 10}}
+	starved := Survivor{Stats: Stats{Health: 100}, Meters: baselineMeters()}
+	choice := Choice{ID: "wait", Cost: Cost{Time: 2}}
+	resFed := ApplyChoice(&fed, choice, DifficultyStandard, 0, nil)
+	resStarved := ApplyChoice(&starved, choice, DifficultyStandard, 0, nil)
+	if resStarved.Delta.Hunger <= resFed.Delta.Hunger || resStarved.Delta.Thirst <= resFed.Delta.Thirst {
+		t.Fatalf("expected empty stocks to raise hunger/thirst faster: fed=%+v starved=%+v", resFed.Delta, resStarved.Delta)
+	}
+}
+
+func TestForageAddsStock(t *testing.T) {
+	seed, _ := NewRunSeed("forage-stock")
+	s := Survivor{Stats: Stats{Health: 100}, Skills: map[Skill]int{SkillScavenging: 3}, Meters: baselineMeters()}
+	consumeSupplies(&s, Choice{Archetype: "forage"}, standardEconomy, seed.Stream("f"))
+	if s.Inventory.FoodDays <= 0 || s.Inventory.WaterLiters <= 0 {
+		t.Fatalf("expected forage to add food and water, got %+v", s.Inventory)
+	}
+	if s.Meters[MeterSupplyOutlook] <= 50 {
+		t.Fatalf("expected outlook to improve after forage, got %d", s.Meters[MeterSupplyOutlook])
+	}
+}
+
+func TestHeatRaisesWaterConsumption(t *testing.T) {
+	mild := Survivor{GroupSize: 1, Meters: baselineMeters(), Inventory: Inventory{WaterLiters: 10}, Environment: Environment{TempBand: TempMild}}
+	hot := Survivor{GroupSize: 1, Meters: baselineMeters(), Inventory: Inventory{WaterLiters: 10}, Environment: Environment{TempBand: TempScorching, Location: LocationDesert}}
+	choice := Choice{Cost: Cost{Time: 2}}
+	mildOut := consumeSupplies(&mild, choice, standardEconomy, nil)
+	hotOut := consumeSupplies(&hot, choice, standardEconomy, nil)
+	if hotOut.Change.WaterLiters >= mildOut.Change.WaterLiters {
+		t.Fatalf("expected heat to drink more water: mild=%.2f hot=%.2f", mildOut.Change.WaterLiters, hotOut.Change.WaterLiters)
+	}
+}
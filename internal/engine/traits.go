@@ -0,0 +1,132 @@
+package engine
+
+// anyArchetype keys trait entries that apply to every archetype.
+const anyArchetype = "*"
+
+// traitEffect describes how a trait bends choice resolution for the survivor who has it.
+type traitEffect struct {
+	RiskShift       map[string]int   // archetype -> risk tier shift
+	Outcome         map[string]Stats // archetype -> stat bonus added to the sampled outcome
+	FatigueCost     int              // added to positive Cost.Fatigue
+	ConditionDamage int              // percent change to condition health damage
+	FatigueGate     int              // extra fatigue allowed before custom actions are refused
+	Blocks          []string         // archetypes the survivor refuses as custom actions
+}
+
+var traitEffects = map[Trait]traitEffect{
+	TraitCautious: {RiskShift: map[string]int{"scout": -1, "observe": -1}},
+	TraitReckless: {
+		RiskShift: map[string]int{anyArchetype: 1},
+		Outcome:   map[string]Stats{anyArchetype: {Morale: 1}},
+	},
+	TraitImpulsive:  {RiskShift: map[string]int{"forage": 1, "scout": 1}},
+	TraitObservant:  {RiskShift: map[string]int{"scout": -1}},
+	TraitStreetwise: {RiskShift: map[string]int{"forage": -1}},
+	TraitResourceful: {
+		Outcome: map[string]Stats{"forage": {Hunger: -1, Thirst: -1}, "craft": {Morale: 1}},
+	},
+	TraitCharismatic: {
+		RiskShift: map[string]int{"diplomacy": -1},
+		Outcome:   map[string]Stats{"diplomacy": {Morale: 1}, "organize": {Morale: 1}},
+	},
+	TraitLoner: {
+		RiskShift: map[string]int{"diplomacy": 1},
+		Outcome:   map[string]Stats{"organize": {Morale: -2}, "diplomacy": {Morale: -3}},
+		Blocks:    []string{"diplomacy"},
+	},
+	TraitParanoid: {
+		RiskShift: map[string]int{"observe": -1},
+		Outcome:   map[string]Stats{"rest": {Morale: -1}},
+		Blocks:    []string{"diplomacy"},
+	},
+	TraitHardy:       {ConditionDamage: -25},
+	TraitResilient:   {ConditionDamage: -15, Outcome: map[string]Stats{"rest": {Morale: 1}}},
+	TraitTireless:    {FatigueCost: -2, FatigueGate: 10},
+	TraitDisciplined: {FatigueGate: 5},
+	TraitOptimistic:  {Outcome: map[string]Stats{"rest": {Morale: 1}}},
+	TraitHaunted:     {Outcome: map[string]Stats{"rest": {Morale: -1}}},
+	TraitTactician:   {RiskShift: map[string]int{"barricade": -1}},
+	TraitMeticulous:  {RiskShift: map[string]int{"craft": -1}},
+}
+
+func traitLookup(m map[string]int, archetype string) int {
+	return m[anyArchetype] + m[archetype]
+}
+
+// traitRiskShift sums the risk tier shifts the survivor's traits apply to an archetype.
+func traitRiskShift(traits []Trait, archetype string) int {
+	shift := 0
+	for _, t := range traits {
+		shift += traitLookup(traitEffects[t].RiskShift, archetype)
+	}
+	return shift
+}
+
+// traitOutcome returns the stat bonus the survivor's traits add to an archetype's outcome.
+func traitOutcome(traits []Trait, archetype string) Stats {
+	total := Stats{}
+	for _, t := range traits {
+		eff := traitEffects[t]
+		total = addStats(total, eff.Outcome[anyArchetype])
+		total = addStats(total, eff.Outcome[archetype])
+	}
+	return total
+}
+
+// traitFatigueCost adjusts a choice's fatigue cost; costs never drop below zero.
+func traitFatigueCost(traits []Trait, cost int) int {
+	if cost <= 0 {
+		return cost
+	}
+	for _, t := range traits {
+		cost += traitEffects[t].FatigueCost
+	}
+	if cost < 0 {
+		cost = 0
+	}
+	return cost
+}
+
+// traitConditionDamage scales condition health damage; hardy survivors shrug some of it off.
+func traitConditionDamage(traits []Trait, damage int) int {
+	if damage >= 0 {
+		return damage
+	}
+	pct := 100
+	for _, t := range traits {
+		pct += traitEffects[t].ConditionDamage
+	}
+	if pct < 25 {
+		pct = 25
+	}
+	return damage * pct / 100
+}
+
+func traitFatigueGate(traits []Trait) int {
+	gate := 0
+	for _, t := range traits {
+		gate += traitEffects[t].FatigueGate
+	}
+	return gate
+}
+
+// traitBlocks returns the first trait that refuses the archetype, if any.
+func traitBlocks(traits []Trait, archetype string) (Trait, bool) {
+	for _, t := range traits {
+		if contains(traitEffects[t].Blocks, archetype) {
+			return t, true
+		}
+	}
+	return "", false
+}
+
+func shiftRisk(r RiskLevel, n int) RiskLevel {
+	score := riskScore(r) + n
+	if score < 0 {
+		score = 0
+	}
+	if score > 2 {
+		score = 2
+	}
+	return riskFromScore(score)
+}
@@ -0,0 +1,60 @@
+package engine
+
+import "testing"
+
+func TestCautiousLowersScoutRisk(t *testing.T) {
+	base := Survivor{Skills: map[Skill]int{SkillNavigation: 2}, Environment: Environment{WorldDay: 0, LAD: 5}}
+	plain := Choice{Archetype: "scout", Risk: RiskModerate}
+	adjustRisk(&plain, base, choiceConfig{difficulty: DifficultyStandard})
+	cautious := Choice{Archetype: "scout", Risk: RiskModerate}
+	base.Traits = []Trait{TraitCautious}
+	adjustRisk(&cautious, base, choiceConfig{difficulty: DifficultyStandard})
+	if riskScore(cautious.Risk) >= riskScore(plain.Risk) {
+		t.Fatalf("expected cautious to lower scout risk: plain=%s cautious=%s", plain.Risk, cautious.Risk)
+	}
+}
+
+func TestRecklessRaisesRiskAndMorale(t *testing.T) {
+	s := Survivor{Skills: map[Skill]int{SkillScavenging: 2}, Traits: []Trait{TraitReckless}, Environment: Environment{LAD: 5}}
+	c := Choice{Archetype: "forage", Risk: RiskLow}
+	adjustRisk(&c, s, choiceConfig{difficulty: DifficultyStandard})
+	if c.Risk != RiskModerate {
+		t.Fatalf("expected reckless forage to be moderate risk, got %s", c.Risk)
+	}
+	if bonus := traitOutcome(s.Traits, "forage"); bonus.Morale != 1 {
+		t.Fatalf("expected reckless morale boost, got %+v", bonus)
+	}
+}
+
+func TestHardyReducesConditionDamage(t *testing.T) {
+	s := Survivor{Conditions: []Condition{ConditionBleeding}, Meters: map[Meter]int{}}
+	plain := conditionTick(&s, DifficultyStandard)
+	s.Traits = []Trait{TraitHardy}
+	hardy := conditionTick(&s, DifficultyStandard)
+	if hardy.Health <= plain.Health {
+		t.Fatalf("expected hardy to take less damage: plain=%d hardy=%d", plain.Health, hardy.Health)
+	}
+}
+
+func TestTirelessLowersFatigueCost(t *testing.T) {
+	if got := traitFatigueCost([]Trait{TraitTireless}, 5); got != 3 {
+		t.Fatalf("expected tireless to shave fatigue cost to 3, got %d", got)
+	}
+	if got := traitFatigueCost([]Trait{TraitTireless}, 1); got != 0 {
+		t.Fatalf("expected fatigue cost floored at 0, got %d", got)
+	}
+	s := Survivor{Stats: Stats{Fatigue: 90}, Traits: []Trait{TraitTireless}}
+	if _, ok, _ := ValidateCustomAction("scout the ridge", s, 0, DifficultyStandard); !ok {
+		t.Fatalf("expected tireless survivor to push on past fatigue 85")
+	}
+}
+
+func TestLonerPenalizesGroupActions(t *testing.T) {
+	s := Survivor{Traits: []Trait{TraitLoner}}
+	if _, ok, _ := ValidateCustomAction("negotiate with the convoy", s, 0, DifficultyStandard); ok {
+		t.Fatalf("expected loner to refuse diplomacy")
+	}
+	if bonus := traitOutcome(s.Traits, "organize"); bonus.Morale >= 0 {
+		t.Fatalf("expected loner morale penalty on organize, got %+v", bonus)
+	}
+}
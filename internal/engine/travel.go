@@ -0,0 +1,336 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// TravelMode is how a survivor covers ground.
+type TravelMode string
+
+const (
+	TravelFoot    TravelMode = "foot"
+	TravelVehicle TravelMode = "vehicle"
+	TravelBoat    TravelMode = "boat"
+)
+
+// travelProfile sets pace, effort and the links a mode can use.
+type travelProfile struct {
+	KMPerUnit float64     // ground covered per time unit
+	Fatigue   int         // per time unit on the road; multi-day trips include rest stops, so only the first day counts
+	Links     []RouteMode // region links the mode can follow
+	Risk      RiskLevel   // exposure on the way once infected are present
+	MaxDays   int         // longest leg the mode can manage in one go; 0 = no limit (vehicles are held back by fuel)
+}
+
+var travelProfiles = map[TravelMode]travelProfile{
+	TravelFoot:    {KMPerUnit: 12, Fatigue: 4, Links: []RouteMode{RouteRoad, RouteRail}, Risk: RiskModerate, MaxDays: 7},
+	TravelVehicle: {KMPerUnit: 120, Fatigue: 1, Links: []RouteMode{RouteRoad}, Risk: RiskModerate},
+	TravelBoat:    {KMPerUnit: 60, Fatigue: 2, Links: []RouteMode{RouteSea}, Risk: RiskLow, MaxDays: 7},
+}
+
+const (
+	fuelItem      = "fuel can"
+	fuelKMPerItem = 200.0 // a vehicle burns one fuel can per this many km
+)
+
+// waterfrontLocations are where boats can be launched or landed.
+var waterfrontLocations = []LocationType{LocationCoast, LocationHarbor, LocationIsland}
+
+// localLink joins two location types inside a region. Sea links need a boat.
+type localLink struct {
+	A, B LocationType
+	KM   float64
+	Sea  bool
+}
+
+// localLinks is the location graph every region shares.
+var localLinks = []localLink{
+	{A: LocationCity, B: LocationSuburb, KM: 15},
+	{A: LocationCity, B: LocationHarbor, KM: 8},
+	{A: LocationCity, B: LocationAirport, KM: 25},
+	{A: LocationCity, B: LocationIndustrial, KM: 10},
+	{A: LocationCity, B: LocationMegastructure, KM: 5},
+	{A: LocationCity, B: LocationSubterranean, KM: 3},
+	{A: LocationSuburb, B: LocationRural, KM: 30},
+	{A: LocationSuburb, B: LocationIndustrial, KM: 12},
+	{A: LocationSuburb, B: LocationStronghold, KM: 20},
+	{A: LocationRural, B: LocationForest, KM: 15},
+	{A: LocationRural, B: LocationCoast, KM: 20},
+	{A: LocationRural, B: LocationResearchOutpost, KM: 40},
+	{A: LocationRural, B: LocationCanyon, KM: 50},
+	{A: LocationRural, B: LocationStronghold, KM: 25},
+	{A: LocationForest, B: LocationMountain, KM: 25},
+	{A: LocationForest, B: LocationMarsh, KM: 20},
+	{A: LocationMountain, B: LocationTundra, KM: 60},
+	{A: LocationMountain, B: LocationPlateau, KM: 35},
+	{A: LocationDesert, B: LocationCanyon, KM: 30},
+	{A: LocationDesert, B: LocationPlateau, KM: 40},
+	{A: LocationCoast, B: LocationHarbor, KM: 10},
+	{A: LocationCoast, B: LocationMarsh, KM: 15},
+	{A: LocationHarbor, B: LocationIndustrial, KM: 6},
+	{A: LocationHarbor, B: LocationIsland, KM: 30, Sea: true},
+	{A: LocationCoast, B: LocationIsland, KM: 20, Sea: true},
+}
+
+// arrivalLocation is where a region crossing ends up for each kind of link.
+var arrivalLocation = map[RouteMode]LocationType{
+	RouteRoad: LocationSuburb,
+	RouteRail: LocationCity,
+	RouteSea:  LocationHarbor,
+}
+
+// TravelRoute is one leg a survivor can take: to another location in the same region, or
+// along a region link to a neighbouring region.
+type TravelRoute struct {
+	Mode         TravelMode
+	Link         RouteMode // road, rail or sea
+	FromRegion   string    // region labels, as on Survivor.Region
+	ToRegion     string
+	FromLocation LocationType
+	ToLocation   LocationType
+	KM           float64
+}
+
+// CrossesRegions reports whether the leg leaves the survivor's region.
+func (r TravelRoute) CrossesRegions() bool { return r.FromRegion != r.ToRegion }
+
+// Describe reads "by foot to suburb, 15 km"; region crossings name the region.
+func (r TravelRoute) Describe() string {
+	dest := string(r.ToLocation)
+	if r.CrossesRegions() {
+		dest = r.ToRegion
+	}
+	return fmt.Sprintf("by %s to %s, %.0f km", r.Mode, dest, r.KM)
+}
+
+// Journey is a completed leg, recorded for the world timeline.
+type Journey struct {
+	TravelRoute
+	DepartDay int
+	ArriveDay int
+	LADBefore int
+	LADAfter  int
+	FuelUsed  int
+}
+
+// Summary is the one-line timeline entry for the journey.
+func (j Journey) Summary() string {
+	days := fmt.Sprintf("Day %d", j.DepartDay)
+	if j.ArriveDay != j.DepartDay {
+		days = fmt.Sprintf("Days %d-%d", j.DepartDay, j.ArriveDay)
+	}
+	return fmt.Sprintf("Travelled %.0f km by %s from %s (%s) to %s (%s), %s", j.KM, j.Mode, j.FromRegion, j.FromLocation, j.ToRegion, j.ToLocation, days)
+}
+
+// TravelOptions lists the legs open to the survivor, local moves first, then region links,
+// each ordered by distance. Region links need the run's graph; boats need a waterfront.
+func TravelOptions(s Survivor, regions *RegionGraph) []TravelRoute {
+	var local, far []TravelRoute
+	here := s.Location
+	afloat := contains(waterfrontLocations, here)
+	for _, l := range localLinks {
+		to := l.B
+		switch here {
+		case l.A:
+		case l.B:
+			to = l.A
+		default:
+			continue
+		}
+		for _, mode := range []TravelMode{TravelFoot, TravelVehicle, TravelBoat} {
+			if l.Sea != (mode == TravelBoat) || (mode == TravelBoat && !afloat) {
+				continue
+			}
+			link := RouteRoad
+			if l.Sea {
+				link = RouteSea
+			}
+			local = append(local, TravelRoute{Mode: mode, Link: link, FromRegion: s.Region, ToRegion: s.Region, FromLocation: here, ToLocation: to, KM: l.KM})
+		}
+	}
+	if regions != nil {
+		if node, ok := regions.NodeByName(s.Region); ok {
+			for _, e := range regions.Neighbors(node.ID) {
+				dest, _ := regions.Node(e.To)
+				for _, mode := range []TravelMode{TravelFoot, TravelVehicle, TravelBoat} {
+					if !contains(travelProfiles[mode].Links, e.Mode) || (mode == TravelBoat && !afloat) {
+						continue
+					}
+					far = append(far, TravelRoute{Mode: mode, Link: e.Mode, FromRegion: s.Region, ToRegion: dest.Name, FromLocation: here, ToLocation: arrivalLocation[e.Mode], KM: e.KM})
+				}
+			}
+		}
+	}
+	byKM := func(routes []TravelRoute) {
+		sort.SliceStable(routes, func(i, j int) bool { return routes[i].KM < routes[j].KM })
+	}
+	byKM(local)
+	byKM(far)
+	return append(local, far...)
+}
+
+// travelUnits is the number of time units a leg takes.
+func travelUnits(r TravelRoute) int {
+	p := travelProfiles[r.Mode]
+	if p.KMPerUnit <= 0 {
+		return 1
+	}
+	units := int(math.Ceil(r.KM / p.KMPerUnit))
+	if units < 1 {
+		units = 1
+	}
+	return units
+}
+
+// fuelNeeded is the number of fuel cans a vehicle burns on a leg.
+func fuelNeeded(r TravelRoute) int {
+	if r.Mode != TravelVehicle {
+		return 0
+	}
+	return int(math.Ceil(r.KM / fuelKMPerItem))
+}
+
+// TravelChoice turns a leg into a travel choice. Food and water for the trip are drawn by
+// the time it takes, so the survivor must carry enough for the whole group; a vehicle must
+// also carry the fuel for the whole leg. Walking and boating are limited to about a week.
+func TravelChoice(s Survivor, route TravelRoute) (Choice, error) {
+	p, ok := travelProfiles[route.Mode]
+	if !ok {
+		return Choice{}, fmt.Errorf("unknown travel mode %q", route.Mode)
+	}
+	if route.Mode == TravelBoat && !contains(waterfrontLocations, s.Location) {
+		return Choice{}, errors.New("boats need a coast, harbor or island to launch from")
+	}
+	if need := fuelNeeded(route); countItem(s.Inventory, fuelItem) < need {
+		return Choice{}, fmt.Errorf("the trip needs %d %s", need, fuelItem)
+	}
+	units := travelUnits(route)
+	if p.MaxDays > 0 && units > p.MaxDays*timeUnitsPerDay {
+		return Choice{}, fmt.Errorf("%.0f km is too far to go by %s in one leg", route.KM, route.Mode)
+	}
+	if food, water := suppliesNeeded(s, units); s.Inventory.FoodDays < food || s.Inventory.WaterLiters < water {
+		return Choice{}, fmt.Errorf("the trip needs %.1f days of food and %.1f L of water", food, water)
+	}
+	profile := archetypeProfiles["travel"]
+	risk := RiskLow
+	if s.Environment.Infected {
+		risk = p.Risk
+	}
+	return Choice{
+		ID:        fmt.Sprintf("travel:%s:%s:%s", route.Mode, slugify(route.ToRegion), route.ToLocation),
+		Label:     "Travel " + route.Describe(),
+		Cost:      Cost{Time: units, Fatigue: p.Fatigue * min(units, timeUnitsPerDay)},
+		Risk:      risk,
+		Archetype: "travel",
+		Outcome:   cloneOutcome(profile.BaseOutcome),
+		Effects:   profile.BaseEffects,
+		Route:     &route,
+	}, nil
+}
+
+// arrive moves the survivor to the end of a leg and refreshes LAD and infection presence for
+// the new place. Region crossings take the destination region's shared LAD from the graph.
+func arrive(s *Survivor, route TravelRoute, regions *RegionGraph, departDay int) Journey {
+	j := Journey{TravelRoute: route, DepartDay: departDay, LADBefore: s.Environment.LAD}
+	if need := fuelNeeded(route); need > 0 {
+		for i := 0; i < need; i++ {
+			if len(loseItems(&s.Inventory, []string{fuelItem})) > 0 {
+				j.FuelUsed++
+			}
+		}
+	}
+	s.Region, s.Environment.Region = route.ToRegion, route.ToRegion
+	s.Location, s.Environment.Location = route.ToLocation, route.ToLocation
+	if regions != nil {
+		if node, ok := regions.NodeByName(route.ToRegion); ok {
+			s.Environment.LAD = node.LAD
+			s.Environment.DistanceToOriginKM = node.OriginKM
+		}
+	}
+	s.syncInfection(regions)
+	j.ArriveDay = s.Environment.WorldDay
+	j.LADAfter = s.Environment.LAD
+	return j
+}
+
+func countItem(inv Inventory, item string) int {
+	n := 0
+	for _, it := range carriedItems(inv) {
+		if it == item {
+			n++
+		}
+	}
+	return n
+}
+
+// travelModeWords pick a mode from the way an action is phrased; walking is the default.
+var travelModeWords = map[TravelMode][]string{
+	TravelVehicle: {"drive", "car", "truck", "van", "ride", "bike", "vehicle"},
+	TravelBoat:    {"sail", "boat", "row", "ferry", "paddle", "ship"},
+}
+
+func travelModeFor(in string) TravelMode {
+	toks := tokenize(in)
+	for _, mode := range []TravelMode{TravelBoat, TravelVehicle} {
+		for _, w := range travelModeWords[mode] {
+			for _, t := range toks {
+				if t == w || stem(t) == w {
+					return mode
+				}
+			}
+		}
+	}
+	return TravelFoot
+}
+
+// routeNamed returns the leg whose destination the text names, preferring the given mode.
+// Region names beat location types so "the harbor in Western Europe" crosses over.
+func routeNamed(options []TravelRoute, text string, mode TravelMode) (TravelRoute, bool) {
+	text = strings.ToLower(text)
+	var best *TravelRoute
+	score := func(r TravelRoute) int {
+		s := 0
+		if r.CrossesRegions() && strings.Contains(text, strings.ToLower(r.ToRegion)) {
+			s += 4
+		}
+		if strings.Contains(text, strings.ReplaceAll(string(r.ToLocation), "_", " ")) {
+			s += 2
+		}
+		if s > 0 && r.Mode == mode {
+			s++
+		}
+		return s
+	}
+	bestScore := 0
+	for i := range options {
+		if sc := score(options[i]); sc > bestScore {
+			best, bestScore = &options[i], sc
+		}
+	}
+	if best == nil {
+		return TravelRoute{}, false
+	}
+	return *best, true
+}
+
+// pickRoute chooses a leg for a planned travel choice from those the survivor can make;
+// without a stream it takes the nearest.
+func pickRoute(s Survivor, regions *RegionGraph, stream *Stream) (Choice, bool) {
+	var open []Choice
+	for _, r := range TravelOptions(s, regions) {
+		if c, err := TravelChoice(s, r); err == nil {
+			open = append(open, c)
+		}
+	}
+	if len(open) == 0 {
+		return Choice{}, false
+	}
+	if stream == nil {
+		return open[0], true
+	}
+	return open[stream.Intn(len(open))], true
+}
@@ -0,0 +1,195 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func travelWorld(t *testing.T) (*World, Survivor) {
+	t.Helper()
+	seed, _ := NewRunSeed("travel")
+	s := testSurvivor("travel", func(s *Survivor) {
+		s.Location, s.Environment.Location = LocationSuburb, LocationSuburb
+		s.Inventory.FoodDays, s.Inventory.WaterLiters = 20, 60
+	})
+	return NewWorld(seed, "1.0.0"), s
+}
+
+func TestTravelOptionsCoverLocalAndRegionLegs(t *testing.T) {
+	w, s := travelWorld(t)
+	local, far := 0, 0
+	for _, r := range TravelOptions(s, w.Regions) {
+		if r.Mode == TravelBoat {
+			t.Fatalf("boats should not launch from a suburb: %+v", r)
+		}
+		if r.CrossesRegions() {
+			far++
+		} else {
+			local++
+		}
+	}
+	if local == 0 || far == 0 {
+		t.Fatalf("expected local and region legs, got %d local and %d region", local, far)
+	}
+	s.Location = LocationHarbor
+	boats := 0
+	for _, r := range TravelOptions(s, w.Regions) {
+		if r.Mode == TravelBoat {
+			boats++
+		}
+	}
+	if boats == 0 {
+		t.Fatalf("expected boat legs from a harbor")
+	}
+}
+
+func TestTravelMovesSurvivorAndRecomputesLAD(t *testing.T) {
+	w, s := travelWorld(t)
+	var leg TravelRoute
+	for _, r := range TravelOptions(s, w.Regions) {
+		if r.CrossesRegions() && r.Mode == TravelVehicle {
+			leg = r
+			break
+		}
+	}
+	if leg.ToRegion == "" {
+		t.Fatalf("expected a region leg by road")
+	}
+	for i := 0; i < fuelNeeded(leg); i++ {
+		s.Inventory.Special = append(s.Inventory.Special, fuelItem)
+	}
+	c, err := TravelChoice(s, leg)
+	if err != nil {
+		t.Fatalf("travel choice: %v", err)
+	}
+	food := s.Inventory.FoodDays
+	res := ApplyChoice(&s, c, DifficultyStandard, 1, w.Seed.Stream("leg"), WithWorld(w))
+	dest, _ := w.Regions.NodeByName(leg.ToRegion)
+	if s.Region != leg.ToRegion || s.Environment.Region != leg.ToRegion || s.Location != leg.ToLocation {
+		t.Fatalf("expected survivor in %s/%s, got %s/%s", leg.ToRegion, leg.ToLocation, s.Region, s.Location)
+	}
+	if s.Environment.LAD != dest.LAD || s.Environment.Infected != (s.Environment.WorldDay >= dest.LAD) {
+		t.Fatalf("expected LAD %d for %s, got %d (infected=%v)", dest.LAD, dest.Name, s.Environment.LAD, s.Environment.Infected)
+	}
+	if res.Journey == nil || res.Journey.ToRegion != leg.ToRegion || res.Journey.ArriveDay <= res.Journey.DepartDay {
+		t.Fatalf("expected a multi-day journey record, got %+v", res.Journey)
+	}
+	if s.Inventory.FoodDays >= food {
+		t.Fatalf("expected the trip to eat into food (%.1f -> %.1f)", food, s.Inventory.FoodDays)
+	}
+	if res.Journey.Summary() == "" {
+		t.Fatalf("expected a timeline summary")
+	}
+}
+
+func TestVehicleTravelNeedsAndBurnsFuel(t *testing.T) {
+	w, s := travelWorld(t)
+	leg := TravelRoute{Mode: TravelVehicle, Link: RouteRoad, FromRegion: s.Region, ToRegion: s.Region, FromLocation: LocationSuburb, ToLocation: LocationRural, KM: 30}
+	if _, err := TravelChoice(s, leg); err == nil {
+		t.Fatalf("expected vehicle travel without fuel to be refused")
+	}
+	s.Inventory.Special = append(s.Inventory.Special, fuelItem)
+	c, err := TravelChoice(s, leg)
+	if err != nil {
+		t.Fatalf("travel choice: %v", err)
+	}
+	walk, _ := TravelChoice(s, TravelRoute{Mode: TravelFoot, Link: RouteRoad, FromRegion: s.Region, ToRegion: s.Region, FromLocation: LocationSuburb, ToLocation: LocationRural, KM: 30})
+	if c.Cost.Time >= walk.Cost.Time || c.Cost.Fatigue >= walk.Cost.Fatigue {
+		t.Fatalf("expected driving to be quicker and easier than walking: %+v vs %+v", c.Cost, walk.Cost)
+	}
+	res := ApplyChoice(&s, c, DifficultyStandard, 1, w.Seed.Stream("drive"), WithWorld(w))
+	if contains(s.Inventory.Special, fuelItem) || !contains(res.Lost, fuelItem) {
+		t.Fatalf("expected the fuel can to be burned, lost=%v", res.Lost)
+	}
+	if s.Location != LocationRural || res.Journey.FuelUsed != 1 {
+		t.Fatalf("expected to arrive rural having used one can, got %s %+v", s.Location, res.Journey)
+	}
+}
+
+func TestTravelRefusesLegsTheSurvivorCannotCover(t *testing.T) {
+	w, s := travelWorld(t)
+	for _, r := range TravelOptions(s, w.Regions) {
+		if r.Mode != TravelFoot {
+			continue
+		}
+		_, err := TravelChoice(s, r)
+		if tooFar := travelUnits(r) > travelProfiles[TravelFoot].MaxDays*timeUnitsPerDay; tooFar != (err != nil) {
+			t.Fatalf("%s: expected refusal only past a week on foot, got %v", r.Describe(), err)
+		}
+	}
+	walk := TravelRoute{Mode: TravelFoot, Link: RouteRoad, FromRegion: s.Region, ToRegion: s.Region, FromLocation: LocationSuburb, ToLocation: LocationRural, KM: 30}
+	s.Inventory.WaterLiters = 0.5
+	if _, err := TravelChoice(s, walk); err == nil {
+		t.Fatalf("expected a leg without water for the way to be refused")
+	}
+	s.Inventory.WaterLiters, s.Inventory.FoodDays = 60, 0.1
+	if _, err := TravelChoice(s, walk); err == nil {
+		t.Fatalf("expected a leg without food for the way to be refused")
+	}
+	s.Inventory.FoodDays = 20
+	if _, err := TravelChoice(s, walk); err != nil {
+		t.Fatalf("expected a supplied walk to be allowed: %v", err)
+	}
+}
+
+func TestCustomTravelFollowsNamedDestination(t *testing.T) {
+	w, s := travelWorld(t)
+	c, ok, reason := ValidateCustomAction("walk to the rural outskirts", s, 5, DifficultyStandard, WithWorld(w))
+	if !ok {
+		t.Fatalf("expected travel to be accepted: %s", reason)
+	}
+	if c.Route == nil || c.Route.ToLocation != LocationRural || c.Route.Mode != TravelFoot {
+		t.Fatalf("expected a foot leg to rural, got %+v", c.Route)
+	}
+	if _, ok, reason := ValidateCustomAction("drive to the industrial park", s, 5, DifficultyStandard, WithWorld(w)); ok || reason == "" {
+		t.Fatalf("expected driving without fuel to be refused")
+	}
+	if _, ok, reason := ValidateCustomAction("move on", s, 5, DifficultyStandard, WithWorld(w)); ok || !strings.Contains(reason, "rural") {
+		t.Fatalf("expected destinationless travel refused with the places in reach, got %q", reason)
+	}
+}
+
+func TestPlannedTravelGetsARoute(t *testing.T) {
+	w, s := travelWorld(t)
+	available := availableEventBlueprints(&s, EventHistory{}, 0)
+	if len(available) == 0 {
+		t.Fatalf("expected events to be available")
+	}
+	planner := &stubPlanner{plan: DirectorPlan{EventID: available[0].ID, Choices: []PlannedChoice{
+		{Label: "Move out", Archetype: "travel", Risk: "low"},
+		{Label: "Rest", Archetype: "rest", Risk: "low"},
+	}}}
+	choices, _, err := GenerateChoices(context.Background(), planner, w.Seed.Stream("gen"), &s, EventHistory{}, 0, WithWorld(w))
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if choices[0].Route == nil || choices[0].Cost.Time != travelUnits(*choices[0].Route) {
+		t.Fatalf("expected planned travel to follow a leg, got %+v", choices[0])
+	}
+	if choices[1].Route != nil {
+		t.Fatalf("only travel choices take routes")
+	}
+}
+
+func TestPlannedTravelWithoutALegIsDropped(t *testing.T) {
+	w, s := travelWorld(t)
+	s.Inventory.FoodDays, s.Inventory.WaterLiters = 0, 0
+	available := availableEventBlueprints(&s, EventHistory{}, 0)
+	planner := &stubPlanner{plan: DirectorPlan{EventID: available[0].ID, Choices: []PlannedChoice{
+		{Label: "Move out", Archetype: "travel", Risk: "low"},
+		{Label: "Rest", Archetype: "rest", Risk: "low"},
+		{Label: "Hide", Archetype: "hide", Risk: "low"},
+	}}}
+	choices, _, err := GenerateChoices(context.Background(), planner, w.Seed.Stream("gen"), &s, EventHistory{}, 0, WithWorld(w))
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if len(choices) != 2 || choices[0].Archetype == "travel" || choices[1].Index != 1 {
+		t.Fatalf("expected the unreachable travel choice dropped, got %+v", choices)
+	}
+	planner.plan.Choices = planner.plan.Choices[:2]
+	if _, _, err := GenerateChoices(context.Background(), planner, w.Seed.Stream("gen"), &s, EventHistory{}, 0, WithWorld(w)); err == nil {
+		t.Fatalf("expected a plan left with one choice to be refused")
+	}
+}
@@ -0,0 +1,126 @@
+package engine
+
+// treatment describes what using one medical item does.
+type treatment struct {
+	Item     string
+	Skill    Skill // medicine for field dressing, pharmacology for drugs
+	MinSkill int   // level needed to use the item at all
+	Cures    []Condition
+	Eases    map[Condition]int // severity levels removed; reaching zero clears the condition
+	Meters   map[Meter]int     // meters set to at least this value (a course of pills lasts about a day)
+	Delta    Stats
+}
+
+var treatmentTable = []treatment{
+	{Item: "bandage", Skill: SkillMedicine, MinSkill: 0, Cures: []Condition{ConditionBleeding}, Delta: Stats{Health: 2}},
+	{Item: "trauma kit", Skill: SkillMedicine, MinSkill: 2, Cures: []Condition{ConditionBleeding, ConditionBurns}, Eases: map[Condition]int{ConditionFracture: 1, ConditionConcussion: 1}, Delta: Stats{Health: 8, Morale: 1}},
+	{Item: "splint", Skill: SkillMedicine, MinSkill: 1, Cures: []Condition{ConditionSprain}, Eases: map[Condition]int{ConditionFracture: 2}},
+	{Item: "burn gel", Skill: SkillMedicine, MinSkill: 0, Cures: []Condition{ConditionBurns}, Delta: Stats{Morale: 1}},
+	{Item: "antiseptic", Skill: SkillMedicine, MinSkill: 1, Cures: []Condition{ConditionContamination}, Eases: map[Condition]int{ConditionInfection: 1}, Delta: Stats{Health: 1}},
+	{Item: "antibiotics", Skill: SkillPharmacology, MinSkill: 1, Cures: []Condition{ConditionInfection}, Eases: map[Condition]int{ConditionSepsis: 2}, Meters: map[Meter]int{MeterAntibioticCourse: 8}},
+	{Item: "antipyretics", Skill: SkillPharmacology, MinSkill: 0, Meters: map[Meter]int{MeterFeverMedication: 8}, Delta: Stats{Fatigue: -2}},
+	{Item: "painkillers", Skill: SkillPharmacology, MinSkill: 0, Cures: []Condition{ConditionPain}, Delta: Stats{Morale: 2}},
+}
+
+// treatmentResult reports what a medicate choice consumed and changed.
+type treatmentResult struct {
+	Item    string
+	Delta   Stats
+	Removed []Condition
+}
+
+func findTreatment(item string) (treatment, bool) {
+	for _, t := range treatmentTable {
+		if t.Item == item {
+			return t, true
+		}
+	}
+	return treatment{}, false
+}
+
+// canUseTreatment reports whether the survivor carries the item and has the skill to use it.
+func canUseTreatment(s Survivor, t treatment) bool {
+	return contains(s.Inventory.Medical, t.Item) && s.Skills[t.Skill] >= t.MinSkill
+}
+
+// treats reports whether the item would do anything for the survivor's current conditions.
+func (t treatment) treats(s Survivor) bool {
+	for _, cond := range t.Cures {
+		if survivorHasCondition(s, cond) {
+			return true
+		}
+	}
+	for cond := range t.Eases {
+		if survivorHasCondition(s, cond) {
+			return true
+		}
+	}
+	return t.Meters[MeterFeverMedication] > 0 && survivorHasCondition(s, ConditionFever)
+}
+
+// bestTreatment picks the first usable item (table order) that helps a current condition.
+func bestTreatment(s Survivor) (treatment, bool) {
+	for _, t := range treatmentTable {
+		if canUseTreatment(s, t) && t.treats(s) {
+			return t, true
+		}
+	}
+	return treatment{}, false
+}
+
+// applyTreatment consumes a medical item and applies its effect. An empty item picks the
+// best match for the survivor's conditions; unusable or missing items do nothing.
+// Skill two or more levels above the minimum eases one extra severity level.
+func applyTreatment(s *Survivor, item string) treatmentResult {
+	res := treatmentResult{}
+	if s == nil {
+		return res
+	}
+	var (
+		t  treatment
+		ok bool
+	)
+	if item == "" {
+		t, ok = bestTreatment(*s)
+	} else if t, ok = findTreatment(item); ok {
+		ok = canUseTreatment(*s, t)
+	}
+	if !ok {
+		return res
+	}
+	removeString(&s.Inventory.Medical, t.Item)
+	res.Item = t.Item
+	res.Delta = t.Delta
+	for _, cond := range t.Cures {
+		if removeConditionIfPresent(s, cond) {
+			res.Removed = append(res.Removed, cond)
+		}
+	}
+	bonus := 0
+	if s.Skills[t.Skill] >= t.MinSkill+2 {
+		bonus = 1
+	}
+	for _, cond := range AllConditions {
+		levels, ok := t.Eases[cond]
+		if !ok || !survivorHasCondition(*s, cond) {
+			continue
+		}
+		st := conditionDetail(s, cond, 0)
+		st.Severity -= levels + bonus
+		if st.Severity <= 0 {
+			removeConditionIfPresent(s, cond)
+			res.Removed = append(res.Removed, cond)
+			continue
+		}
+		s.ConditionDetails[cond] = st
+	}
+	if s.Meters == nil {
+		s.Meters = make(map[Meter]int)
+	}
+	for m, v := range t.Meters {
+		if s.Meters[m] < v {
+			s.Meters[m] = v
+		}
+	}
+	return res
+}
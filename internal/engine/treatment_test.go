@@ -0,0 +1,79 @@
+package engine
+
+import "testing"
+
+func TestBandageStopsBleedingAndIsConsumed(t *testing.T) {
+	s := Survivor{Stats: Stats{Health: 60}, Skills: map[Skill]int{}, Conditions: []Condition{ConditionBleeding}, Meters: baselineMeters(), Inventory: Inventory{Medical: []string{"bandage", "bandage"}, FoodDays: 2, WaterLiters: 6}}
+	res := ApplyChoice(&s, Choice{ID: "treat", Archetype: "medicate", Cost: Cost{Time: 1}}, DifficultyStandard, 1, nil)
+	if res.Used != "bandage" {
+		t.Fatalf("expected bandage used, got %q", res.Used)
+	}
+	if survivorHasCondition(s, ConditionBleeding) {
+		t.Fatalf("expected bleeding stopped")
+	}
+	if len(s.Inventory.Medical) != 1 {
+		t.Fatalf("expected one bandage consumed, got %v", s.Inventory.Medical)
+	}
+}
+
+func TestTreatmentGatedOnSkill(t *testing.T) {
+	s := Survivor{Skills: map[Skill]int{SkillPharmacology: 0}, Conditions: []Condition{ConditionInfection}, Inventory: Inventory{Medical: []string{"antibiotics"}}}
+	if res := applyTreatment(&s, "antibiotics"); res.Item != "" {
+		t.Fatalf("expected antibiotics unusable without pharmacology")
+	}
+	if !survivorHasCondition(s, ConditionInfection) || len(s.Inventory.Medical) != 1 {
+		t.Fatalf("gated treatment should not change state: %+v", s)
+	}
+	s.Skills[SkillPharmacology] = 1
+	res := applyTreatment(&s, "antibiotics")
+	if res.Item != "antibiotics" || survivorHasCondition(s, ConditionInfection) {
+		t.Fatalf("expected antibiotics to clear infection, got %+v", res)
+	}
+}
+
+func TestAntipyreticsAndRestCureFever(t *testing.T) {
+	s := Survivor{Stats: Stats{Health: 80}, Skills: map[Skill]int{}, Conditions: []Condition{ConditionFever}, Meters: baselineMeters(), Inventory: Inventory{Medical: []string{"antipyretics"}, FoodDays: 5, WaterLiters: 20}}
+	res := ApplyChoice(&s, Choice{ID: "meds", Archetype: "medicate", Cost: Cost{Time: 1}}, DifficultyStandard, 1, nil)
+	if res.Used != "antipyretics" {
+		t.Fatalf("expected antipyretics used, got %q", res.Used)
+	}
+	for turn := 2; turn <= 6; turn++ {
+		ApplyChoice(&s, Choice{ID: "rest", Archetype: "rest", Cost: Cost{Time: 1}}, DifficultyStandard, turn, nil)
+	}
+	if survivorHasCondition(s, ConditionFever) {
+		t.Fatalf("expected medicated rest to break the fever, meters=%v", s.Meters)
+	}
+}
+
+func TestFeverNeedsMedicationNotJustRest(t *testing.T) {
+	s := Survivor{Conditions: []Condition{ConditionFever}, Meters: map[Meter]int{}}
+	for i := 0; i < 6; i++ {
+		advanceConditions(&s, DifficultyStandard, Choice{Archetype: "rest"}, Stats{}, i)
+	}
+	if s.Meters[MeterFeverRest] != 6 {
+		t.Fatalf("expected fever rest to accumulate, got %d", s.Meters[MeterFeverRest])
+	}
+	if !survivorHasCondition(s, ConditionFever) {
+		t.Fatalf("expected fever to persist without medication")
+	}
+}
+
+func TestSkilledSplintEasesFracture(t *testing.T) {
+	s := Survivor{Skills: map[Skill]int{SkillMedicine: 3}, Conditions: []Condition{ConditionFracture}, ConditionDetails: map[Condition]ConditionState{ConditionFracture: {Severity: 4}}, Inventory: Inventory{Medical: []string{"splint"}}}
+	applyTreatment(&s, "")
+	if got := s.ConditionDetails[ConditionFracture].Severity; got != 1 {
+		t.Fatalf("expected skilled splint to ease fracture to severity 1, got %d", got)
+	}
+}
+
+func TestCustomMedicateNeedsSupplies(t *testing.T) {
+	s := Survivor{Skills: map[Skill]int{}, Conditions: []Condition{ConditionBleeding}}
+	if _, ok, _ := ValidateCustomAction("bandage my arm", s, 0, DifficultyStandard); ok {
+		t.Fatalf("expected medicate rejected without supplies")
+	}
+	s.Inventory.Medical = []string{"bandage"}
+	c, ok, reason := ValidateCustomAction("bandage my arm", s, 0, DifficultyStandard)
+	if !ok || c.Archetype != "medicate" || c.Item != "bandage" {
+		t.Fatalf("expected bandage custom action, got %+v ok=%v reason=%q", c, ok, reason)
+	}
+}
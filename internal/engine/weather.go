@@ -0,0 +1,173 @@
+package engine
+
+import "fmt"
+
+const (
+	seasonLengthDays = 91
+	// weatherEpochDay is where every region's weather chain starts; it predates the earliest researcher start.
+	weatherEpochDay = -10
+)
+
+// seasonCycle is the calendar order of seasons (AllSeasons is alphabetical).
+var seasonCycle = []Season{SeasonSpring, SeasonSummer, SeasonAutumn, SeasonWinter}
+
+// tempScale orders temperature bands from coldest to hottest for drift.
+var tempScale = []TempBand{TempArctic, TempFreezing, TempCold, TempMild, TempWarm, TempHot, TempScorching}
+
+type tempRange struct{ Min, Max TempBand }
+
+var seasonTempRanges = map[Season]tempRange{
+	SeasonSpring: {Min: TempCold, Max: TempWarm},
+	SeasonSummer: {Min: TempMild, Max: TempScorching},
+	SeasonAutumn: {Min: TempFreezing, Max: TempMild},
+	SeasonWinter: {Min: TempArctic, Max: TempCold},
+}
+
+// seasonClimate holds the stationary weather weights for each season.
+var seasonClimate = map[Season]map[Weather]int{
+	SeasonSpring: {WeatherClear: 6, WeatherOvercast: 5, WeatherRain: 6, WeatherStorm: 2, WeatherFog: 3, WeatherHail: 1, WeatherMonsoon: 1},
+	SeasonSummer: {WeatherClear: 8, WeatherOvercast: 2, WeatherRain: 2, WeatherStorm: 2, WeatherHeatwave: 3, WeatherDustStorm: 1, WeatherSmoke: 1, WeatherHail: 1, WeatherMonsoon: 1},
+	SeasonAutumn: {WeatherClear: 4, WeatherOvercast: 6, WeatherRain: 6, WeatherStorm: 2, WeatherFog: 4, WeatherSmoke: 1, WeatherAshfall: 1},
+	SeasonWinter: {WeatherClear: 4, WeatherOvercast: 5, WeatherSnow: 6, WeatherBlizzard: 2, WeatherFog: 3, WeatherHail: 1},
+}
+
+// weatherPersistence is the weight of staying in the same state the next day.
+var weatherPersistence = map[Weather]int{
+	WeatherClear: 8, WeatherOvercast: 6, WeatherRain: 5, WeatherStorm: 2, WeatherSnow: 6, WeatherFog: 3,
+	WeatherSmoke: 4, WeatherAshfall: 3, WeatherHail: 1, WeatherDustStorm: 2, WeatherBlizzard: 2,
+	WeatherHeatwave: 6, WeatherMonsoon: 4,
+}
+
+// weatherFollowUps bias what a state tends to turn into (storms clear to rain, smoke settles as ash).
+var weatherFollowUps = map[Weather]map[Weather]int{
+	WeatherStorm:     {WeatherRain: 6, WeatherOvercast: 3, WeatherHail: 2},
+	WeatherBlizzard:  {WeatherSnow: 6, WeatherOvercast: 2},
+	WeatherHeatwave:  {WeatherDustStorm: 2, WeatherSmoke: 2, WeatherStorm: 2},
+	WeatherSmoke:     {WeatherAshfall: 4, WeatherOvercast: 2},
+	WeatherAshfall:   {WeatherOvercast: 4, WeatherSmoke: 1},
+	WeatherRain:      {WeatherStorm: 2, WeatherFog: 2},
+	WeatherDustStorm: {WeatherClear: 4, WeatherHeatwave: 2},
+	WeatherMonsoon:   {WeatherRain: 6, WeatherStorm: 2},
+}
+
+// weatherRow returns the transition weights out of state w for a season.
+func weatherRow(season Season, w Weather) map[Weather]int {
+	row := make(map[Weather]int)
+	for next, weight := range seasonClimate[season] {
+		row[next] += weight
+	}
+	for next, weight := range weatherFollowUps[w] {
+		row[next] += weight
+	}
+	if _, ok := seasonClimate[season][w]; ok || len(row) == 0 {
+		row[w] += weatherPersistence[w]
+	}
+	return row
+}
+
+func sampleWeather(row map[Weather]int, stream *Stream) Weather {
+	total := 0
+	for _, w := range AllWeathers {
+		total += row[w]
+	}
+	if total == 0 {
+		return WeatherClear
+	}
+	pick := stream.Intn(total)
+	for _, w := range AllWeathers {
+		if pick < row[w] {
+			return w
+		}
+		pick -= row[w]
+	}
+	return WeatherClear
+}
+
+// RegionSeason returns the season for a region on a world day; regions start at a seeded point in the year.
+func RegionSeason(seed RunSeed, region string, day int) Season {
+	offset := seed.Stream("season:" + region).Intn(len(seasonCycle) * seasonLengthDays)
+	n := len(seasonCycle)
+	idx := (floorDiv(day+offset, seasonLengthDays)%n + n) % n
+	return seasonCycle[idx]
+}
+
+// RegionWeather simulates the region's weather chain up to day and returns that day's weather and temperature.
+// Every survivor in the same region on the same day sees the same result.
+func RegionWeather(seed RunSeed, region string, day int) (Season, Weather, TempBand) {
+	start := weatherEpochDay
+	if day < start {
+		start = day
+	}
+	season := RegionSeason(seed, region, start)
+	chain := seed.Stream("weather:" + region)
+	weather := sampleWeather(seasonClimate[season], chain.Child(fmt.Sprintf("day:%d", start)))
+	rng := seasonTempRanges[season]
+	temp := tempIndex(rng.Min) + chain.Child("temp").Intn(tempIndex(rng.Max)-tempIndex(rng.Min)+1)
+	temp = forceTemp(weather, clampTemp(temp, rng))
+	for d := start + 1; d <= day; d++ {
+		season = RegionSeason(seed, region, d)
+		dayStream := chain.Child(fmt.Sprintf("day:%d", d))
+		weather = sampleWeather(weatherRow(season, weather), dayStream.Child("weather"))
+		temp += dayStream.Child("temp").Intn(4)/2 - dayStream.Child("temp-down").Intn(4)/2
+		temp = forceTemp(weather, clampTemp(temp, seasonTempRanges[season]))
+	}
+	return season, weather, tempScale[temp]
+}
+
+// SyncWeather updates the survivor's season, weather and temperature for their region and day.
+// Call it after spawning a survivor; the clock calls it on every day rollover.
+func (w *World) SyncWeather(s *Survivor) {
+	if w == nil || s == nil {
+		return
+	}
+	season, weather, temp := RegionWeather(w.Seed, s.Region, s.Environment.WorldDay)
+	s.Environment.Season = season
+	s.Environment.Weather = weather
+	s.Environment.TempBand = temp
+}
+
+func tempIndex(t TempBand) int {
+	for i, b := range tempScale {
+		if b == t {
+			return i
+		}
+	}
+	return tempIndex(TempMild)
+}
+
+func clampTemp(idx int, rng tempRange) int {
+	if lo := tempIndex(rng.Min); idx < lo {
+		return lo
+	}
+	if hi := tempIndex(rng.Max); idx > hi {
+		return hi
+	}
+	return idx
+}
+
+// forceTemp nudges temperature to agree with extreme weather.
+func forceTemp(w Weather, idx int) int {
+	switch w {
+	case WeatherHeatwave:
+		if idx < tempIndex(TempHot) {
+			return tempIndex(TempHot)
+		}
+	case WeatherBlizzard:
+		if idx > tempIndex(TempFreezing) {
+			return tempIndex(TempFreezing)
+		}
+	case WeatherSnow:
+		if idx > tempIndex(TempCold) {
+			return tempIndex(TempCold)
+		}
+	}
+	return idx
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
@@ -0,0 +1,69 @@
+package engine
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRegionWeatherSharedAndDeterministic(t *testing.T) {
+	seed, _ := NewRunSeed("weather")
+	w := NewWorld(seed, "1.0.0")
+	a := Survivor{Region: "Western Europe", Environment: Environment{WorldDay: 42}}
+	b := Survivor{Region: "Western Europe", Environment: Environment{WorldDay: 42, Weather: WeatherBlizzard}}
+	w.SyncWeather(&a)
+	w.SyncWeather(&b)
+	if a.Environment.Weather != b.Environment.Weather || a.Environment.TempBand != b.Environment.TempBand || a.Environment.Season != b.Environment.Season {
+		t.Fatalf("survivors in the same region and day should share weather: %+v vs %+v", a.Environment, b.Environment)
+	}
+}
+
+func TestRegionWeatherEvolves(t *testing.T) {
+	seed, _ := NewRunSeed("weather-evolves")
+	seen := map[Weather]bool{}
+	for day := 0; day < 365; day += 3 {
+		_, weather, temp := RegionWeather(seed, "Northern Europe", day)
+		seen[weather] = true
+		if !temp.Validate() {
+			t.Fatalf("invalid temp band %q on day %d", temp, day)
+		}
+	}
+	if len(seen) < 5 {
+		t.Fatalf("expected weather to vary across a year, saw %v", seen)
+	}
+}
+
+func TestBlizzardDoesNotPersistOutOfSeason(t *testing.T) {
+	row := weatherRow(SeasonSummer, WeatherBlizzard)
+	if row[WeatherBlizzard] != 0 {
+		t.Fatalf("summer should not keep a blizzard going")
+	}
+}
+
+func TestRegionSeasonHandlesPreOutbreakDays(t *testing.T) {
+	seed, _ := NewRunSeed("weather-negative")
+	for _, region := range []string{"Oceania", "Central China", "Midwest, USA", "South Asia"} {
+		for day := -12; day <= 0; day++ {
+			if !RegionSeason(seed, region, day).Validate() {
+				t.Fatalf("invalid season for %s on day %d", region, day)
+			}
+		}
+	}
+}
+
+func TestSpawnedSurvivorsShareRegionWeather(t *testing.T) {
+	seed, _ := NewRunSeed("weather-spawn")
+	w := NewWorld(seed, "1.0.0")
+	first := NewFirstSurvivor(seed.Stream("first"), seed, w.OriginSite)
+	synced := first
+	w.SyncWeather(&synced)
+	if first.Environment != synced.Environment {
+		t.Fatalf("expected spawn weather to match the region's: %+v vs %+v", first.Environment, synced.Environment)
+	}
+	for i := 0; i < 20; i++ {
+		s := NewGenericSurvivor(seed.Stream(fmt.Sprintf("sv:%d", i)), seed, 12, w.Regions)
+		season, weather, temp := RegionWeather(seed, s.Region, 12)
+		if s.Environment.Season != season || s.Environment.Weather != weather || s.Environment.TempBand != temp {
+			t.Fatalf("survivor in %s spawned into %s/%s/%s, region has %s/%s/%s", s.Region, s.Environment.Season, s.Environment.Weather, s.Environment.TempBand, season, weather, temp)
+		}
+	}
+}
@@ -62,6 +62,8 @@ type Run struct {
 	CurrentDay   int
 	SeedText     string
 	RulesVersion string
+	ContentPacks []string            // pack refs (id@version); empty for runs created before content packs
+	Regions      *engine.RegionGraph // nil for runs created before region graphs
 	ProfileID    uuid.UUID
 	LastPlayedAt time.Time
 }
@@ -137,16 +139,17 @@ func (pr *ProfileRepo) Touch(ctx context.Context, id uuid.UUID) error {
 	return pr.db.gorm.WithContext(ctx).Exec(`UPDATE profiles SET last_used_at = now() WHERE id = ?`, id).Error
 }
 
-// CreateWithSeed inserts a run with canonical seed text for the provided profile.
-func (r *RunRepo) CreateWithSeed(ctx context.Context, profileID uuid.UUID, origin, seedText, rulesVersion string) (Run, error) {
+// CreateWithSeed inserts a run with canonical seed text and its enabled content packs for the provided profile.
+func (r *RunRepo) CreateWithSeed(ctx context.Context, profileID uuid.UUID, origin, seedText, rulesVersion string, contentPacks []string) (Run, error) {
 	if profileID == uuid.Nil {
 		return Run{}, errs.New("profile id required")
 	}
 	id := uuid.New()
-	if err := r.db.gorm.Exec(`INSERT INTO runs(id, profile_id, origin_site, seed, rules_version, last_played_at) VALUES(?,?,?,?,?,now())`, id, profileID, origin, seedText, rulesVersion).Error; err != nil {
+	packs := pqStringArrayStr(contentPacks)
+	if err := r.db.gorm.Exec(`INSERT INTO runs(id, profile_id, origin_site, seed, rules_version, content_packs, last_played_at) VALUES(?,?,?,?,?,?,now())`, id, profileID, origin, seedText, rulesVersion, pq.Array(packs)).Error; err != nil {
 		return Run{}, err
 	}
-	return Run{ID: id, OriginSite: origin, CurrentDay: 0, SeedText: seedText, RulesVersion: rulesVersion, ProfileID: profileID, LastPlayedAt: time.Now()}, nil
+	return Run{ID: id, OriginSite: origin, CurrentDay: 0, SeedText: seedText, RulesVersion: rulesVersion, ContentPacks: packs, ProfileID: profileID, LastPlayedAt: time.Now()}, nil
 }
 
 // Legacy Create retained for backwards compatibility.
@@ -169,12 +172,15 @@ func hydrateSurvivorRecord(
 	groupSize int,
 	traitArr pq.StringArray,
 	skillsJSON []byte,
+	skillXPJSON []byte,
 	statsJSON []byte,
 	bodyTemp string,
 	conditionArr pq.StringArray,
+	conditionDetailsJSON []byte,
 	metersJSON []byte,
 	inventoryJSON []byte,
 	environmentJSON []byte,
+	lastCustom string,
 	alive bool,
 ) (engine.Survivor, error) {
 	sv := engine.Survivor{
@@ -186,6 +192,7 @@ func hydrateSurvivorRecord(
 		Group:      engine.GroupType(groupType),
 		GroupSize:  groupSize,
 		BodyTemp:   engine.TempBand(bodyTemp),
+		LastCustom: lastCustom,
 		Alive:      alive,
 	}
 	if len(traitArr) > 0 {
@@ -206,6 +213,20 @@ func hydrateSurvivorRecord(
 			}
 		}
 	}
+	if len(conditionDetailsJSON) > 0 {
+		details := map[engine.Condition]engine.ConditionState{}
+		if err := json.Unmarshal(conditionDetailsJSON, &details); err != nil {
+			return engine.Survivor{}, err
+		}
+		for cond, st := range details {
+			if cond.Validate() {
+				if sv.ConditionDetails == nil {
+					sv.ConditionDetails = make(map[engine.Condition]engine.ConditionState, len(details))
+				}
+				sv.ConditionDetails[cond] = st
+			}
+		}
+	}
 	if len(statsJSON) > 0 {
 		if err := json.Unmarshal(statsJSON, &sv.Stats); err != nil {
 			return engine.Survivor{}, err
@@ -226,6 +247,21 @@ func hydrateSurvivorRecord(
 			sv.Skills[sk] = 0
 		}
 	}
+	if len(skillXPJSON) > 0 {
+		xpMap := map[string]int{}
+		if err := json.Unmarshal(skillXPJSON, &xpMap); err != nil {
+			return engine.Survivor{}, err
+		}
+		for raw, xp := range xpMap {
+			sk := engine.Skill(raw)
+			if sk.Validate() && xp > 0 {
+				if sv.SkillXP == nil {
+					sv.SkillXP = make(map[engine.Skill]int, len(xpMap))
+				}
+				sv.SkillXP[sk] = xp
+			}
+		}
+	}
 	meterMap := map[string]int{}
 	if len(metersJSON) > 0 {
 		if err := json.Unmarshal(metersJSON, &meterMap); err != nil {
@@ -256,18 +292,23 @@ func hydrateSurvivorRecord(
 func (s *SurvivorRepo) Create(ctx context.Context, runID uuid.UUID, sv engine.Survivor) (uuid.UUID, error) {
 	id := uuid.New()
 	skills, _ := json.Marshal(sv.Skills)
+	skillXP := skillXPJSON(sv.SkillXP)
 	stats, _ := json.Marshal(sv.Stats)
+	details := conditionDetailsJSON(sv.ConditionDetails)
 	meters, _ := json.Marshal(sv.Meters)
 	inv, _ := json.Marshal(sv.Inventory)
 	env, _ := json.Marshal(sv.Environment)
 	err := s.db.gorm.Exec(`INSERT INTO survivors(
-		id, run_id, name, age, background, region, location_type, group_type, group_size, traits, skills, stats, body_temp, conditions, meters, inventory, environment, alive
-	) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)`,
-		id, runID, sv.Name, sv.Age, sv.Background, sv.Region, sv.Location, sv.Group, sv.GroupSize, pq.Array(pqStringArray(sv.Traits)), skills, stats, sv.BodyTemp, pq.Array(pqStringArray(sv.Conditions)), meters, inv, env, sv.Alive,
+		id, run_id, name, age, background, region, location_type, group_type, group_size, traits, skills, skill_xp, stats, body_temp, conditions, condition_details, meters, inventory, environment, last_custom, alive
+	) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)`,
+		id, runID, sv.Name, sv.Age, sv.Background, sv.Region, sv.Location, sv.Group, sv.GroupSize, pq.Array(pqStringArray(sv.Traits)), skills, skillXP, stats, sv.BodyTemp, pq.Array(pqStringArray(sv.Conditions)), details, meters, inv, env, sv.LastCustom, sv.Alive,
 	).Error
 	if err != nil {
 		return uuid.Nil, err
 	}
+	if err := NewCompanionRepo(s.db).Save(ctx, nil, id, sv.Companions); err != nil {
+		return uuid.Nil, err
+	}
 	return id, nil
 }
 
@@ -355,6 +396,14 @@ func NewEventRepo(db *DB) *EventRepo { return &EventRepo{db: db} }
 
 type NarrationCacheRepo struct{ db *DB }
 
+type JourneyRepo struct{ db *DB }
+
+func NewJourneyRepo(db *DB) *JourneyRepo { return &JourneyRepo{db: db} }
+
+type CompanionRepo struct{ db *DB }
+
+func NewCompanionRepo(db *DB) *CompanionRepo { return &CompanionRepo{db: db} }
+
 func NewNarrationCacheRepo(db *DB) *NarrationCacheRepo { return &NarrationCacheRepo{db: db} }
 
 type EventInstanceRecord struct {
@@ -364,12 +413,16 @@ type EventInstanceRecord struct {
 	WorldDay           int
 	SceneIdx           int
 	CooldownUntilScene int
+	ArcID              string // empty when the event is not part of an arc
+	ArcStep            int
 	OnceFired          bool
 }
 
-func (er *EventRepo) LoadHistory(ctx context.Context, tx *gorm.DB, runID uuid.UUID) (engine.EventHistory, error) {
+// LoadHistory restores run-wide event cooldowns plus the arc progress of survivorID.
+func (er *EventRepo) LoadHistory(ctx context.Context, tx *gorm.DB, runID, survivorID uuid.UUID) (engine.EventHistory, error) {
 	hist := engine.EventHistory{
 		Events: make(map[string]engine.EventState),
+		Arcs:   make(map[string]engine.ArcProgress),
 	}
 	if runID == uuid.Nil {
 		return hist, nil
@@ -378,21 +431,31 @@ func (er *EventRepo) LoadHistory(ctx context.Context, tx *gorm.DB, runID uuid.UU
 	if tx != nil {
 		db = tx.WithContext(ctx)
 	}
-	rows, err := db.Raw(`SELECT event_id, scene_idx, cooldown_until_scene, once_fired FROM event_instances WHERE run_id = ? ORDER BY scene_idx DESC, created_at DESC`, runID).Rows()
+	rows, err := db.Raw(`SELECT survivor_id, event_id, scene_idx, cooldown_until_scene, COALESCE(arc_id, ''), COALESCE(arc_step, 0), once_fired FROM event_instances WHERE run_id = ? ORDER BY scene_idx DESC, created_at DESC`, runID).Rows()
 	if err != nil {
 		return hist, err
 	}
 	defer rows.Close()
+	arcSeen := map[string]bool{}
 	for rows.Next() {
 		var (
+			ownerID   uuid.UUID
 			eventID   string
 			sceneIdx  int
 			cooldown  int
+			arcID     string
+			arcStep   int
 			onceFired bool
 		)
-		if err := rows.Scan(&eventID, &sceneIdx, &cooldown, &onceFired); err != nil {
+		if err := rows.Scan(&ownerID, &eventID, &sceneIdx, &cooldown, &arcID, &arcStep, &onceFired); err != nil {
 			return hist, err
 		}
+		// rows arrive newest first, so the first hit per arc is the survivor's latest step;
+		// finished arcs stay cleared so they can reopen
+		if arcID != "" && ownerID == survivorID && !arcSeen[arcID] {
+			arcSeen[arcID] = true
+			hist.RestoreArc(arcID, engine.ArcProgress{Step: arcStep, LastSceneIdx: sceneIdx})
+		}
 		if _, ok := hist.Events[eventID]; !ok {
 			hist.Events[eventID] = engine.EventState{
 				LastSceneIdx:       sceneIdx,
@@ -410,8 +473,12 @@ func (er *EventRepo) Insert(ctx context.Context, tx *gorm.DB, rec EventInstanceR
 	if tx != nil {
 		db = tx.WithContext(ctx)
 	}
+	var arcID any
+	if rec.ArcID != "" {
+		arcID = rec.ArcID
+	}
 	return db.Exec(`INSERT INTO event_instances(run_id, survivor_id, event_id, world_day, scene_idx, cooldown_until_scene, arc_id, arc_step, once_fired) VALUES (?,?,?,?,?,?,?,?,?)`,
-		rec.RunID, rec.SurvivorID, rec.EventID, rec.WorldDay, rec.SceneIdx, rec.CooldownUntilScene, nil, 0, rec.OnceFired).Error
+		rec.RunID, rec.SurvivorID, rec.EventID, rec.WorldDay, rec.SceneIdx, rec.CooldownUntilScene, arcID, rec.ArcStep, rec.OnceFired).Error
 }
 
 func (nr *NarrationCacheRepo) Get(ctx context.Context, tx *gorm.DB, runID uuid.UUID, kind string, hash []byte) (string, bool, error) {
@@ -453,59 +520,78 @@ func pqStringArray[T ~string](in []T) []string {
 }
 
 func (r *RunRepo) Get(ctx context.Context, id uuid.UUID) (Run, error) {
-	row := r.db.gorm.WithContext(ctx).Raw(`SELECT id, origin_site, current_day, COALESCE(seed, ''), COALESCE(rules_version,'1.0.0'), profile_id, COALESCE(last_played_at, now()) FROM runs WHERE id = ?`, id).Row()
-	var rr Run
-	if err := row.Scan(&rr.ID, &rr.OriginSite, &rr.CurrentDay, &rr.SeedText, &rr.RulesVersion, &rr.ProfileID, &rr.LastPlayedAt); err != nil {
+	row := r.db.gorm.WithContext(ctx).Raw(`SELECT id, origin_site, current_day, COALESCE(seed, ''), COALESCE(rules_version,'1.0.0'), COALESCE(content_packs, '{}'), region_graph, profile_id, COALESCE(last_played_at, now()) FROM runs WHERE id = ?`, id).Row()
+	var (
+		rr      Run
+		regions []byte
+	)
+	if err := row.Scan(&rr.ID, &rr.OriginSite, &rr.CurrentDay, &rr.SeedText, &rr.RulesVersion, pq.Array(&rr.ContentPacks), &regions, &rr.ProfileID, &rr.LastPlayedAt); err != nil {
 		return Run{}, err
 	}
-	return rr, nil
+	return rr, decodeRegionGraph(regions, &rr)
 }
 
 // GetLatestRun returns most recently played run for profile.
 func (r *RunRepo) GetLatestRun(ctx context.Context, profileID uuid.UUID) (Run, error) {
-	row := r.db.gorm.WithContext(ctx).Raw(`SELECT id, origin_site, current_day, COALESCE(seed, ''), COALESCE(rules_version,'1.0.0'), profile_id, COALESCE(last_played_at, now()) FROM runs WHERE profile_id = ? ORDER BY last_played_at DESC LIMIT 1`, profileID).Row()
-	var rr Run
-	if err := row.Scan(&rr.ID, &rr.OriginSite, &rr.CurrentDay, &rr.SeedText, &rr.RulesVersion, &rr.ProfileID, &rr.LastPlayedAt); err != nil {
+	row := r.db.gorm.WithContext(ctx).Raw(`SELECT id, origin_site, current_day, COALESCE(seed, ''), COALESCE(rules_version,'1.0.0'), COALESCE(content_packs, '{}'), region_graph, profile_id, COALESCE(last_played_at, now()) FROM runs WHERE profile_id = ? ORDER BY last_played_at DESC LIMIT 1`, profileID).Row()
+	var (
+		rr      Run
+		regions []byte
+	)
+	if err := row.Scan(&rr.ID, &rr.OriginSite, &rr.CurrentDay, &rr.SeedText, &rr.RulesVersion, pq.Array(&rr.ContentPacks), &regions, &rr.ProfileID, &rr.LastPlayedAt); err != nil {
 		return Run{}, err
 	}
-	return rr, nil
+	return rr, decodeRegionGraph(regions, &rr)
 }
 
 // SurvivorRepo additions
 func (s *SurvivorRepo) Get(ctx context.Context, id uuid.UUID) (engine.Survivor, error) {
-	row := s.db.gorm.WithContext(ctx).Raw(`SELECT name, age, background, region, location_type, group_type, group_size, traits, skills, stats, body_temp, conditions, meters, inventory, environment, alive FROM survivors WHERE id = ?`, id).Row()
+	row := s.db.gorm.WithContext(ctx).Raw(`SELECT name, age, background, region, location_type, group_type, group_size, traits, skills, skill_xp, stats, body_temp, conditions, condition_details, meters, inventory, environment, last_custom, alive FROM survivors WHERE id = ?`, id).Row()
 	var (
 		name, background, region, locationType, groupType, bodyTemp string
+		lastCustom                                                  string
 		age, groupSize                                              int
 		traits pq.StringArray
 		conditions pq.StringArray
-		skillsB, statsB, metersB, invB, envB                        []byte
+		skillsB, xpB, statsB, detailsB, metersB, invB, envB         []byte
 		alive                                                       bool
 	)
-	if err := row.Scan(&name, &age, &background, &region, &locationType, &groupType, &groupSize, &traits, &skillsB, &statsB, &bodyTemp, &conditions, &metersB, &invB, &envB, &alive); err != nil {
+	if err := row.Scan(&name, &age, &background, &region, &locationType, &groupType, &groupSize, &traits, &skillsB, &xpB, &statsB, &bodyTemp, &conditions, &detailsB, &metersB, &invB, &envB, &lastCustom, &alive); err != nil {
+		return engine.Survivor{}, err
+	}
+	sv, err := hydrateSurvivorRecord(name, age, background, region, locationType, groupType, groupSize, traits, skillsB, xpB, statsB, bodyTemp, conditions, detailsB, metersB, invB, envB, lastCustom, alive)
+	if err != nil {
 		return engine.Survivor{}, err
 	}
-	return hydrateSurvivorRecord(name, age, background, region, locationType, groupType, groupSize, traits, skillsB, statsB, bodyTemp, conditions, metersB, invB, envB, alive)
+	if sv.Companions, err = NewCompanionRepo(s.db).List(ctx, id); err != nil {
+		return engine.Survivor{}, err
+	}
+	return sv, nil
 }
 
 // GetAliveSurvivor returns latest alive survivor for run (simple max updated_at ordering).
 func (s *SurvivorRepo) GetAliveSurvivor(ctx context.Context, runID uuid.UUID) (engine.Survivor, uuid.UUID, error) {
-	row := s.db.gorm.WithContext(ctx).Raw(`SELECT id, name, age, background, region, location_type, group_type, group_size, traits, skills, stats, body_temp, conditions, meters, inventory, environment, alive FROM survivors WHERE run_id = ? AND alive = TRUE ORDER BY updated_at DESC LIMIT 1`, runID).Row()
+	row := s.db.gorm.WithContext(ctx).Raw(`SELECT id, name, age, background, region, location_type, group_type, group_size, traits, skills, skill_xp, stats, body_temp, conditions, condition_details, meters, inventory, environment, last_custom, alive FROM survivors WHERE run_id = ? AND alive = TRUE ORDER BY updated_at DESC LIMIT 1`, runID).Row()
 	var (
 		id                                                          uuid.UUID
 		name, background, region, locationType, groupType, bodyTemp string
+		lastCustom                                                  string
 		age, groupSize                                              int
 		traitsArr, condsArr                                         []string
-		skillsB, statsB, metersB, invB, envB                        []byte
+		skillsB, xpB, statsB, detailsB, metersB, invB, envB         []byte
 		alive                                                       bool
 	)
-	if err := row.Scan(&id, &name, &age, &background, &region, &locationType, &groupType, &groupSize, pq.Array(&traitsArr), &skillsB, &statsB, &bodyTemp, pq.Array(&condsArr), &metersB, &invB, &envB, &alive); err != nil {
+	if err := row.Scan(&id, &name, &age, &background, &region, &locationType, &groupType, &groupSize, pq.Array(&traitsArr), &skillsB, &xpB, &statsB, &bodyTemp, pq.Array(&condsArr), &detailsB, &metersB, &invB, &envB, &lastCustom, &alive); err != nil {
 		return engine.Survivor{}, uuid.Nil, err
 	}
 	var skills map[engine.Skill]int
 	_ = json.Unmarshal(skillsB, &skills)
+	var skillXP map[engine.Skill]int
+	_ = json.Unmarshal(xpB, &skillXP)
 	var stats engine.Stats
 	_ = json.Unmarshal(statsB, &stats)
+	var details map[engine.Condition]engine.ConditionState
+	_ = json.Unmarshal(detailsB, &details)
 	var meters map[engine.Meter]int
 	_ = json.Unmarshal(metersB, &meters)
 	var inv engine.Inventory
@@ -520,7 +606,12 @@ func (s *SurvivorRepo) GetAliveSurvivor(ctx context.Context, runID uuid.UUID) (e
 	for i, c := range condsArr {
 		conds[i] = engine.Condition(c)
 	}
-	surv := engine.Survivor{Name: name, Age: age, Background: background, Region: region, Location: engine.LocationType(locationType), Group: engine.GroupType(groupType), GroupSize: groupSize, Traits: traits, Skills: skills, Stats: stats, BodyTemp: engine.TempBand(bodyTemp), Conditions: conds, Meters: meters, Inventory: inv, Environment: env, Alive: alive}
+	surv := engine.Survivor{Name: name, Age: age, Background: background, Region: region, Location: engine.LocationType(locationType), Group: engine.GroupType(groupType), GroupSize: groupSize, Traits: traits, Skills: skills, SkillXP: skillXP, Stats: stats, BodyTemp: engine.TempBand(bodyTemp), Conditions: conds, ConditionDetails: details, Meters: meters, Inventory: inv, Environment: env, LastCustom: lastCustom, Alive: alive}
+	companions, err := NewCompanionRepo(s.db).List(ctx, id)
+	if err != nil {
+		return engine.Survivor{}, uuid.Nil, err
+	}
+	surv.Companions = companions
 	return surv, id, nil
 }
 
@@ -600,6 +691,97 @@ func (ar *ArchiveRepo) List(ctx context.Context, runID uuid.UUID, limit int) ([]
 	return res, nil
 }
 
+// JourneyRecord is a stored travel leg.
+type JourneyRecord struct {
+	ID         uuid.UUID
+	RunID      uuid.UUID
+	SurvivorID uuid.UUID
+	Journey    engine.Journey
+}
+
+// Insert records a completed travel leg.
+func (jr *JourneyRepo) Insert(ctx context.Context, tx *gorm.DB, runID, survivorID uuid.UUID, j engine.Journey) (uuid.UUID, error) {
+	id := uuid.New()
+	exec := jr.db.gorm.WithContext(ctx)
+	if tx != nil {
+		exec = tx.WithContext(ctx)
+	}
+	if err := exec.Exec(`INSERT INTO journeys(id, run_id, survivor_id, mode, link, from_region, to_region, from_location, to_location, km, depart_day, arrive_day, lad_before, lad_after) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?)`,
+		id, runID, survivorID, string(j.Mode), string(j.Link), j.FromRegion, j.ToRegion, string(j.FromLocation), string(j.ToLocation), j.KM, j.DepartDay, j.ArriveDay, j.LADBefore, j.LADAfter).Error; err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+// List returns a run's travel legs in timeline order.
+func (jr *JourneyRepo) List(ctx context.Context, runID uuid.UUID) ([]JourneyRecord, error) {
+	rows, err := jr.db.gorm.WithContext(ctx).Raw(`SELECT id, run_id, survivor_id, mode, link, from_region, to_region, from_location, to_location, km, depart_day, arrive_day, lad_before, lad_after FROM journeys WHERE run_id = ? ORDER BY depart_day, created_at`, runID).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var res []JourneyRecord
+	for rows.Next() {
+		var (
+			rec                  JourneyRecord
+			mode, link, from, to string
+		)
+		j := &rec.Journey
+		if err := rows.Scan(&rec.ID, &rec.RunID, &rec.SurvivorID, &mode, &link, &j.FromRegion, &j.ToRegion, &from, &to, &j.KM, &j.DepartDay, &j.ArriveDay, &j.LADBefore, &j.LADAfter); err != nil {
+			return nil, err
+		}
+		j.Mode, j.Link = engine.TravelMode(mode), engine.RouteMode(link)
+		j.FromLocation, j.ToLocation = engine.LocationType(from), engine.LocationType(to)
+		res = append(res, rec)
+	}
+	return res, nil
+}
+
+// Save upserts a survivor's companions by name, keeping the dead and departed with their status.
+func (cr *CompanionRepo) Save(ctx context.Context, tx *gorm.DB, survivorID uuid.UUID, companions []engine.Companion) error {
+	exec := cr.db.gorm.WithContext(ctx)
+	if tx != nil {
+		exec = tx.WithContext(ctx)
+	}
+	for _, c := range companions {
+		skills, _ := json.Marshal(c.Skills)
+		if err := exec.Exec(`INSERT INTO companions(id, survivor_id, name, profession, skills, health, morale, loyalty, status) VALUES (?,?,?,?,?,?,?,?,?)
+	ON CONFLICT (survivor_id, name) DO UPDATE SET profession=EXCLUDED.profession, skills=EXCLUDED.skills, health=EXCLUDED.health, morale=EXCLUDED.morale, loyalty=EXCLUDED.loyalty, status=EXCLUDED.status, updated_at=now()`,
+			uuid.New(), survivorID, c.Name, c.Profession, skills, c.Health, c.Morale, c.Loyalty, string(c.Status)).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// List returns a survivor's companions in the order they joined.
+func (cr *CompanionRepo) List(ctx context.Context, survivorID uuid.UUID) ([]engine.Companion, error) {
+	rows, err := cr.db.gorm.WithContext(ctx).Raw(`SELECT name, profession, skills, health, morale, loyalty, status FROM companions WHERE survivor_id = ? ORDER BY created_at, name`, survivorID).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var res []engine.Companion
+	for rows.Next() {
+		var (
+			c       engine.Companion
+			skillsB []byte
+			status  string
+		)
+		if err := rows.Scan(&c.Name, &c.Profession, &skillsB, &c.Health, &c.Morale, &c.Loyalty, &status); err != nil {
+			return nil, err
+		}
+		if len(skillsB) > 0 {
+			if err := json.Unmarshal(skillsB, &c.Skills); err != nil {
+				return nil, err
+			}
+		}
+		c.Status = engine.CompanionStatus(status)
+		res = append(res, c)
+	}
+	return res, nil
+}
+
 // SettingsRepo
 type SettingsRepo struct{ db *DB }
 
@@ -668,17 +850,68 @@ func (tr *TipRepo) All(ctx context.Context) ([]Tip, error) {
 // SurvivorRepo Update method for transactional survivor state persistence.
 func (s *SurvivorRepo) Update(ctx context.Context, tx *gorm.DB, id uuid.UUID, sv engine.Survivor) error {
 	skills, _ := json.Marshal(sv.Skills)
+	skillXP := skillXPJSON(sv.SkillXP)
 	stats, _ := json.Marshal(sv.Stats)
 	meters, _ := json.Marshal(sv.Meters)
 	inv, _ := json.Marshal(sv.Inventory)
 	env, _ := json.Marshal(sv.Environment)
 	conds := pqStringArray(sv.Conditions)
+	details := conditionDetailsJSON(sv.ConditionDetails)
 	exec := s.db.gorm.WithContext(ctx)
 	if tx != nil {
 		exec = tx.WithContext(ctx)
 	}
-	return exec.Exec(`UPDATE survivors SET skills = ?, stats = ?, body_temp = ?, conditions = ?, meters = ?, inventory = ?, environment = ?, alive = ? WHERE id = ?`,
-		skills, stats, sv.BodyTemp, pq.Array(conds), meters, inv, env, sv.Alive, id).Error
+	if err := exec.Exec(`UPDATE survivors SET region = ?, location_type = ?, group_type = ?, group_size = ?, skills = ?, skill_xp = ?, stats = ?, body_temp = ?, conditions = ?, condition_details = ?, meters = ?, inventory = ?, environment = ?, last_custom = ?, alive = ? WHERE id = ?`,
+		sv.Region, string(sv.Location), string(sv.Group), sv.GroupSize, skills, skillXP, stats, sv.BodyTemp, pq.Array(conds), details, meters, inv, env, sv.LastCustom, sv.Alive, id).Error; err != nil {
+		return err
+	}
+	return NewCompanionRepo(s.db).Save(ctx, tx, id, sv.Companions)
+}
+
+// conditionDetailsJSON encodes condition progression; nil maps are stored as an empty object.
+func conditionDetailsJSON(details map[engine.Condition]engine.ConditionState) []byte {
+	if len(details) == 0 {
+		return []byte("{}")
+	}
+	b, _ := json.Marshal(details)
+	return b
+}
+
+// skillXPJSON encodes skill experience; nil maps are stored as an empty object.
+func skillXPJSON(xp map[engine.Skill]int) []byte {
+	if len(xp) == 0 {
+		return []byte("{}")
+	}
+	b, _ := json.Marshal(xp)
+	return b
+}
+
+func decodeRegionGraph(b []byte, rr *Run) error {
+	if len(b) == 0 {
+		return nil
+	}
+	var g engine.RegionGraph
+	if err := json.Unmarshal(b, &g); err != nil {
+		return fmt.Errorf("decode region graph: %w", err)
+	}
+	rr.Regions = &g
+	return nil
+}
+
+// SaveRegions persists the run's region graph so region LADs stay fixed across sessions.
+func (r *RunRepo) SaveRegions(ctx context.Context, tx *gorm.DB, id uuid.UUID, g *engine.RegionGraph) error {
+	if g == nil {
+		return errs.New("region graph required")
+	}
+	b, err := json.Marshal(g)
+	if err != nil {
+		return err
+	}
+	exec := r.db.gorm.WithContext(ctx)
+	if tx != nil {
+		exec = tx.WithContext(ctx)
+	}
+	return exec.Exec(`UPDATE runs SET region_graph = ? WHERE id = ?`, b, id).Error
 }
 
 // RunRepo day update